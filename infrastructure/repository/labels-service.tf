@@ -170,9 +170,9 @@ variable "service_labels" {
     "lakeformation",
     "lambda",
     "lexmodels",
-    "lexmodelsv2",
     "lexruntime",
     "lexruntimev2",
+    "lexv2models",
     "licensemanager",
     "lightsail",
     "location",
@@ -20,6 +20,7 @@ import (
 const (
 	PEMBlockTypeCertificate        = `CERTIFICATE`
 	PEMBlockTypeCertificateRequest = `CERTIFICATE REQUEST`
+	PEMBlockTypeCRL                = `X509 CRL`
 	PEMBlockTypeECPrivateKey       = `EC PRIVATE KEY`
 	PEMBlockTypeRSAPrivateKey      = `RSA PRIVATE KEY`
 	PEMBlockTypePublicKey          = `PUBLIC KEY`
@@ -192,6 +193,46 @@ func TLSRSAX509LocallySignedCertificatePEM(t *testing.T, caKeyPem, caCertificate
 	return string(pem.EncodeToMemory(certificateBlock))
 }
 
+// TLSRSAX509CRLPEM generates an empty x509 certificate revocation list PEM string signed by the specified CA.
+// Wrap with TLSPEMEscapeNewlines() to allow simple fmt.Sprintf()
+// configurations such as: crl_data = "%[1]s"
+func TLSRSAX509CRLPEM(t *testing.T, caKeyPem, caCertificatePem string) string {
+	caCertificateBlock, _ := pem.Decode([]byte(caCertificatePem))
+
+	caCertificate, err := x509.ParseCertificate(caCertificateBlock.Bytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	caKeyBlock, _ := pem.Decode([]byte(caKeyPem))
+
+	caKey, err := x509.ParsePKCS1PrivateKey(caKeyBlock.Bytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	revocationList := &x509.RevocationList{
+		NextUpdate: time.Now().Add(24 * time.Hour), //nolint:gomnd
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now(),
+	}
+
+	crlBytes, err := x509.CreateRevocationList(rand.Reader, revocationList, caCertificate, caKey)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	crlBlock := &pem.Block{
+		Bytes: crlBytes,
+		Type:  PEMBlockTypeCRL,
+	}
+
+	return string(pem.EncodeToMemory(crlBlock))
+}
+
 // TLSRSAX509SelfSignedCACertificatePEM generates a x509 CA certificate PEM string.
 // Wrap with TLSPEMEscapeNewlines() to allow simple fmt.Sprintf()
 // configurations such as: root_certificate_pem = "%[1]s"
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/service/s3"
 )
@@ -43,3 +44,53 @@ func (client *AWSClient) SetHTTPClient(httpClient *http.Client) {
 func (client *AWSClient) HTTPClient() *http.Client {
 	return client.httpClient
 }
+
+// Memoize returns the result of calling f, caching any successful result under key
+// for the lifetime of the provider configuration.
+// Concurrent calls for the same key wait for the first to complete.
+// Only use for read-only lookups whose results cannot change during a Terraform run.
+func (client *AWSClient) Memoize(key string, f func() (any, error)) (any, error) {
+	return client.memo.get(key, f)
+}
+
+type memoEntry struct {
+	mu    sync.Mutex
+	ok    bool
+	value any
+}
+
+type memoCache struct {
+	mu      sync.Mutex
+	entries map[string]*memoEntry
+}
+
+func (c *memoCache) get(key string, f func() (any, error)) (any, error) {
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = make(map[string]*memoEntry)
+	}
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &memoEntry{}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.ok {
+		return entry.value, nil
+	}
+
+	value, err := f()
+
+	if err != nil {
+		return nil, err
+	}
+
+	entry.ok = true
+	entry.value = value
+
+	return value, nil
+}
@@ -323,19 +323,21 @@ import (
 )
 
 type AWSClient struct {
-	AccountID               string
-	DefaultTagsConfig       *tftags.DefaultConfig
-	DNSSuffix               string
-	IgnoreTagsConfig        *tftags.IgnoreConfig
-	MediaConvertAccountConn *mediaconvert.MediaConvert
-	Partition               string
-	Region                  string
-	ReverseDNSPrefix        string
-	ServicePackages         []intf.ServicePackage
-	Session                 *session.Session
-	TerraformVersion        string
+	AccountID                string
+	DefaultTagsConfig        *tftags.DefaultConfig
+	DNSSuffix                string
+	IgnoreTagsConfig         *tftags.IgnoreConfig
+	MediaConvertAccountConn  *mediaconvert.MediaConvert
+	Partition                string
+	PreventDestroyTagsConfig *tftags.PreventDestroyConfig
+	Region                   string
+	ReverseDNSPrefix         string
+	ServicePackages          []intf.ServicePackage
+	Session                  *session.Session
+	TerraformVersion         string
 
 	httpClient *http.Client
+	memo       memoCache
 
 	ec2Client       lazyClient[*ec2_sdkv2.Client]
 	logsClient      lazyClient[*cloudwatchlogs_sdkv2.Client]
@@ -509,7 +511,7 @@ type AWSClient struct {
 	lakeformationConn                *lakeformation.LakeFormation
 	lambdaConn                       *lambda.Lambda
 	lexmodelsConn                    *lexmodelbuildingservice.LexModelBuildingService
-	lexmodelsv2Conn                  *lexmodelsv2.LexModelsV2
+	lexv2modelsConn                  *lexmodelsv2.LexModelsV2
 	lexruntimeConn                   *lexruntimeservice.LexRuntimeService
 	lexruntimev2Conn                 *lexruntimev2.LexRuntimeV2
 	licensemanagerConn               *licensemanager.LicenseManager
@@ -1324,7 +1326,7 @@ func (client *AWSClient) LexModelsConn() *lexmodelbuildingservice.LexModelBuildi
 }
 
 func (client *AWSClient) LexModelsV2Conn() *lexmodelsv2.LexModelsV2 {
-	return client.lexmodelsv2Conn
+	return client.lexv2modelsConn
 }
 
 func (client *AWSClient) LexRuntimeConn() *lexruntimeservice.LexRuntimeService {
@@ -1,6 +1,7 @@
 package conns
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -87,3 +88,47 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 		})
 	}
 }
+
+func TestAWSClientMemoize(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{}
+	calls := 0
+	f := func() (any, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("test error")
+		}
+		return calls, nil
+	}
+
+	if _, err := client.Memoize("key", f); err == nil {
+		t.Fatal("expected error")
+	}
+
+	for i := 0; i < 2; i++ {
+		got, err := client.Memoize("key", f)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got != 2 {
+			t.Errorf("got %v, expected 2", got)
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("got %d calls, expected 2", calls)
+	}
+
+	got, err := client.Memoize("other", f)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != 3 {
+		t.Errorf("got %v, expected 3", got)
+	}
+}
@@ -59,6 +59,7 @@ type Config struct {
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
+	PreventDestroyTagsConfig       *tftags.PreventDestroyConfig
 	Profile                        string
 	Region                         string
 	S3UsePathStyle                 bool
@@ -184,6 +185,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.DNSSuffix = DNSSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
+	client.PreventDestroyTagsConfig = c.PreventDestroyTagsConfig
 	client.Region = c.Region
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.SetHTTPClient(sess.Config.HTTPClient) // Must be called while client.Session is nil.
@@ -4,7 +4,10 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -108,3 +111,51 @@ func WarnLog(service, action, resource, id string, gotError error) {
 func LogNotFoundRemoveState(service, action, resource, id string) {
 	WarnLog(service, action, resource, id, errors.New("not found, removing from state"))
 }
+
+// AppendWarningNotFoundRemoveState logs and returns diags with an additional diag.Diagnostic
+// containing a warning that a resource was not found and is being removed from state.
+// The warning detail includes the last-known ARN and the AWS error code of gotError, when available.
+func AppendWarningNotFoundRemoveState(diags diag.Diagnostics, service, action, resource, id, arn string, gotError error) diag.Diagnostics {
+	var details []string
+
+	if arn != "" {
+		details = append(details, fmt.Sprintf("Last-known ARN: %s", arn))
+	}
+
+	if code := awsErrorCode(gotError); code != "" {
+		details = append(details, fmt.Sprintf("AWS error code: %s", code))
+	}
+
+	detail := strings.Join(details, "\n")
+
+	if detail == "" {
+		LogNotFoundRemoveState(service, action, resource, id)
+	} else {
+		WarnLog(service, action, resource, id, fmt.Errorf("not found, removing from state (%s)", strings.Join(details, ", ")))
+	}
+
+	return append(diags,
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  ProblemStandardMessage(service, action, resource, id, errors.New("not found, removing from state")),
+			Detail:   detail,
+		},
+	)
+}
+
+// awsErrorCode returns the AWS error code of an AWS SDK for Go v1 or v2 error, if any.
+func awsErrorCode(err error) string {
+	var awsErr awserr.Error
+
+	if errors.As(err, &awsErr) {
+		return awsErr.Code()
+	}
+
+	var apiErr smithy.APIError
+
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode()
+	}
+
+	return ""
+}
@@ -0,0 +1,75 @@
+package create
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAppendWarningNotFoundRemoveState(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName       string
+		ARN            string
+		Error          error
+		ExpectedDetail string
+	}{
+		{
+			TestName:       "no details",
+			ExpectedDetail: "",
+		},
+		{
+			TestName:       "ARN",
+			ARN:            "arn:aws:cognito-identity:us-west-2:123456789012:identitypool/us-west-2:1",                 //lintignore:AWSAT003,AWSAT005
+			ExpectedDetail: "Last-known ARN: arn:aws:cognito-identity:us-west-2:123456789012:identitypool/us-west-2:1", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			TestName:       "AWS error",
+			Error:          awserr.New("ResourceNotFoundException", "not found", nil),
+			ExpectedDetail: "AWS error code: ResourceNotFoundException",
+		},
+		{
+			TestName:       "wrapped AWS error",
+			Error:          fmt.Errorf("reading: %w", awserr.New("ResourceNotFoundException", "not found", nil)),
+			ExpectedDetail: "AWS error code: ResourceNotFoundException",
+		},
+		{
+			TestName:       "non-AWS error",
+			Error:          errors.New("test"),
+			ExpectedDetail: "",
+		},
+		{
+			TestName:       "ARN and AWS error",
+			ARN:            "arn:aws:cognito-identity:us-west-2:123456789012:identitypool/us-west-2:1", //lintignore:AWSAT003,AWSAT005
+			Error:          awserr.New("ResourceNotFoundException", "not found", nil),
+			ExpectedDetail: "Last-known ARN: arn:aws:cognito-identity:us-west-2:123456789012:identitypool/us-west-2:1\nAWS error code: ResourceNotFoundException", //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			diags = AppendWarningNotFoundRemoveState(diags, names.CognitoIdentity, ErrActionReading, "Pool", "us-west-2:1", testCase.ARN, testCase.Error) //lintignore:AWSAT003
+
+			if got, want := len(diags), 1; got != want {
+				t.Fatalf("got %d diagnostics, expected %d", got, want)
+			}
+
+			if got, want := diags[0].Severity, diag.Warning; got != want {
+				t.Errorf("got severity %v, expected %v", got, want)
+			}
+
+			if got, want := diags[0].Detail, testCase.ExpectedDetail; got != want {
+				t.Errorf("got detail %q, expected %q", got, want)
+			}
+		})
+	}
+}
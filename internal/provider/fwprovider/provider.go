@@ -8,14 +8,18 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -278,6 +282,26 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"prevent_destroy_tags": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to prevent the deletion of tagged resources across all resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag keys which prevent deletion regardless of value.",
+						},
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tags which prevent deletion when both key and value match.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -461,6 +485,14 @@ func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteReq
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Delete enter", w.typeName))
 
+	if w.meta != nil {
+		response.Diagnostics.Append(preventDestroyTags(ctx, w.meta.PreventDestroyTagsConfig, request.State)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	w.inner.Delete(ctx, request, response)
 
 	tflog.Debug(ctx, fmt.Sprintf("%s.Delete exit", w.typeName))
@@ -520,3 +552,37 @@ func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.V
 		v.ValidateConfig(ctx, request, response)
 	}
 }
+
+// preventDestroyTags returns an error if the resource's tags in state match the provider's prevent_destroy_tags configuration.
+func preventDestroyTags(ctx context.Context, config *tftags.PreventDestroyConfig, state tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if config == nil {
+		return diags
+	}
+
+	for _, k := range []string{"tags_all", "tags"} {
+		if _, ok := state.Schema.GetAttributes()[k]; !ok {
+			continue
+		}
+
+		var tags types.Map
+
+		diags.Append(state.GetAttribute(ctx, path.Root(k), &tags)...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		if keys := config.ProtectingKeys(tftags.New(tags)); len(keys) > 0 {
+			diags.AddError(
+				"Resource Deletion Prevented",
+				fmt.Sprintf("prevented by provider prevent_destroy_tags configuration, matching tag keys: %s", strings.Join(keys, ", ")),
+			)
+		}
+
+		break
+	}
+
+	return diags
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
@@ -123,16 +124,19 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/location"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediastore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
@@ -143,6 +147,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/polly"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
@@ -151,6 +156,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/rolesanywhere"
@@ -176,6 +182,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/shield"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/signer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/snowball"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
@@ -183,13 +190,16 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/swf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamquery"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/translate"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/worklink"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/xray"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -305,6 +315,29 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"being executed. If the API request still fails, an error is\n" +
 					"thrown.",
 			},
+			"prevent_destroy_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to prevent the deletion of tagged resources across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag keys which prevent deletion regardless of value.",
+						},
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tags which prevent deletion when both key and value match.",
+						},
+					},
+				},
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -442,6 +475,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_appmesh_mesh":            appmesh.DataSourceMesh(),
 			"aws_appmesh_virtual_service": appmesh.DataSourceVirtualService(),
 
+			"aws_apprunner_custom_domain_association": apprunner.DataSourceCustomDomainAssociation(),
+
 			"aws_autoscaling_group":    autoscaling.DataSourceGroup(),
 			"aws_autoscaling_groups":   autoscaling.DataSourceGroups(),
 			"aws_launch_configuration": autoscaling.DataSourceLaunchConfiguration(),
@@ -459,7 +494,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_ce_cost_category": ce.DataSourceCostCategory(),
 			"aws_ce_tags":          ce.DataSourceTags(),
 
-			"aws_cloudcontrolapi_resource": cloudcontrol.DataSourceResource(),
+			"aws_cloudcontrolapi_resource":        cloudcontrol.DataSourceResource(),
+			"aws_cloudcontrolapi_resource_exists": cloudcontrol.DataSourceResourceExists(),
 
 			"aws_cloudformation_export": cloudformation.DataSourceExport(),
 			"aws_cloudformation_stack":  cloudformation.DataSourceStack(),
@@ -491,6 +527,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_codestarconnections_connection": codestarconnections.DataSourceConnection(),
 
+			"aws_cognito_identity_pool":                 cognitoidentity.DataSourcePool(),
+			"aws_cognito_identity_pool_identities":      cognitoidentity.DataSourcePoolIdentities(),
 			"aws_cognito_user_pool_client":              cognitoidp.DataSourceUserPoolClient(),
 			"aws_cognito_user_pool_clients":             cognitoidp.DataSourceUserPoolClients(),
 			"aws_cognito_user_pool_signing_certificate": cognitoidp.DataSourceUserPoolSigningCertificate(),
@@ -518,6 +556,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_datapipeline_pipeline":            datapipeline.DataSourcePipeline(),
 			"aws_datapipeline_pipeline_definition": datapipeline.DataSourcePipelineDefinition(),
 
+			"aws_devicefarm_test_grid_session_artifacts": devicefarm.DataSourceTestGridSessionArtifacts(),
+
 			"aws_docdb_engine_version":        docdb.DataSourceEngineVersion(),
 			"aws_docdb_orderable_db_instance": docdb.DataSourceOrderableDBInstance(),
 
@@ -527,7 +567,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_dx_locations":            directconnect.DataSourceLocations(),
 			"aws_dx_router_configuration": directconnect.DataSourceRouterConfiguration(),
 
-			"aws_directory_service_directory": ds.DataSourceDirectory(),
+			"aws_directory_service_directory":          ds.DataSourceDirectory(),
+			"aws_directory_service_domain_controllers": ds.DataSourceDomainControllers(),
 
 			"aws_dynamodb_table":      dynamodb.DataSourceTable(),
 			"aws_dynamodb_table_item": dynamodb.DataSourceTableItem(),
@@ -588,6 +629,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_network_acls":                               ec2.DataSourceNetworkACLs(),
 			"aws_network_interface":                          ec2.DataSourceNetworkInterface(),
 			"aws_network_interfaces":                         ec2.DataSourceNetworkInterfaces(),
+			"aws_network_path_between":                       ec2.DataSourceNetworkPathBetween(),
 			"aws_prefix_list":                                ec2.DataSourcePrefixList(),
 			"aws_route_table":                                ec2.DataSourceRouteTable(),
 			"aws_route_tables":                               ec2.DataSourceRouteTables(),
@@ -667,6 +709,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_fsx_openzfs_snapshot": fsx.DataSourceOpenzfsSnapshot(),
 
+			"aws_gamelift_compute_auth_token": gamelift.DataSourceComputeAuthToken(),
+
 			"aws_glue_catalog_table":                    glue.DataSourceCatalogTable(),
 			"aws_glue_connection":                       glue.DataSourceConnection(),
 			"aws_glue_data_catalog_encryption_settings": glue.DataSourceDataCatalogEncryptionSettings(),
@@ -674,7 +718,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_grafana_workspace": grafana.DataSourceWorkspace(),
 
-			"aws_guardduty_detector": guardduty.DataSourceDetector(),
+			"aws_guardduty_detector":         guardduty.DataSourceDetector(),
+			"aws_guardduty_member_detectors": guardduty.DataSourceMemberDetectors(),
 
 			"aws_iam_account_alias":           iam.DataSourceAccountAlias(),
 			"aws_iam_group":                   iam.DataSourceGroup(),
@@ -766,6 +811,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_location_tracker_association":  location.DataSourceTrackerAssociation(),
 			"aws_location_tracker_associations": location.DataSourceTrackerAssociations(),
 
+			"aws_managedblockchain_node": managedblockchain.DataSourceNode(),
+
 			"aws_memorydb_acl":             memorydb.DataSourceACL(),
 			"aws_memorydb_cluster":         memorydb.DataSourceCluster(),
 			"aws_memorydb_parameter_group": memorydb.DataSourceParameterGroup(),
@@ -794,7 +841,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_networkmanager_site":                         networkmanager.DataSourceSite(),
 			"aws_networkmanager_sites":                        networkmanager.DataSourceSites(),
 
-			"aws_opensearch_domain": opensearch.DataSourceDomain(),
+			"aws_opensearch_compatible_versions":         opensearch.DataSourceCompatibleVersions(),
+			"aws_opensearch_domain":                      opensearch.DataSourceDomain(),
+			"aws_opensearch_reserved_instance_offerings": opensearch.DataSourceReservedInstanceOfferings(),
 
 			"aws_organizations_delegated_administrators": organizations.DataSourceDelegatedAdministrators(),
 			"aws_organizations_delegated_services":       organizations.DataSourceDelegatedServices(),
@@ -806,6 +855,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_outposts_assets":                 outposts.DataSourceOutpostAssets(),
 			"aws_outposts_outpost":                outposts.DataSourceOutpost(),
 			"aws_outposts_outpost_instance_type":  outposts.DataSourceOutpostInstanceType(),
+			"aws_outposts_order":                  outposts.DataSourceOrder(),
+			"aws_outposts_orders":                 outposts.DataSourceOrders(),
 			"aws_outposts_outpost_instance_types": outposts.DataSourceOutpostInstanceTypes(),
 			"aws_outposts_outposts":               outposts.DataSourceOutposts(),
 			"aws_outposts_site":                   outposts.DataSourceSite(),
@@ -899,6 +950,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_signer_signing_job":     signer.DataSourceSigningJob(),
 			"aws_signer_signing_profile": signer.DataSourceSigningProfile(),
 
+			"aws_snowball_address": snowball.DataSourceAddress(),
+
 			"aws_sns_topic": sns.DataSourceTopic(),
 
 			"aws_sqs_queue":  sqs.DataSourceQueue(),
@@ -916,7 +969,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_storagegateway_local_disk": storagegateway.DataSourceLocalDisk(),
 
-			"aws_transfer_server": transfer.DataSourceServer(),
+			"aws_transfer_server":                 transfer.DataSourceServer(),
+			"aws_transfer_test_identity_provider": transfer.DataSourceTestIdentityProvider(),
 
 			"aws_waf_ipset":                 waf.DataSourceIPSet(),
 			"aws_waf_rule":                  waf.DataSourceRule(),
@@ -991,6 +1045,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_api_gateway_stage":                 apigateway.ResourceStage(),
 			"aws_api_gateway_usage_plan":            apigateway.ResourceUsagePlan(),
 			"aws_api_gateway_usage_plan_key":        apigateway.ResourceUsagePlanKey(),
+			"aws_api_gateway_usage_plan_keys":       apigateway.ResourceUsagePlanKeys(),
 			"aws_api_gateway_vpc_link":              apigateway.ResourceVPCLink(),
 
 			"aws_apigatewayv2_api":                  apigatewayv2.ResourceAPI(),
@@ -1063,14 +1118,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_athena_named_query":  athena.ResourceNamedQuery(),
 			"aws_athena_workgroup":    athena.ResourceWorkGroup(),
 
-			"aws_autoscaling_attachment":     autoscaling.ResourceAttachment(),
-			"aws_autoscaling_group":          autoscaling.ResourceGroup(),
-			"aws_autoscaling_group_tag":      autoscaling.ResourceGroupTag(),
-			"aws_autoscaling_lifecycle_hook": autoscaling.ResourceLifecycleHook(),
-			"aws_autoscaling_notification":   autoscaling.ResourceNotification(),
-			"aws_autoscaling_policy":         autoscaling.ResourcePolicy(),
-			"aws_autoscaling_schedule":       autoscaling.ResourceSchedule(),
-			"aws_launch_configuration":       autoscaling.ResourceLaunchConfiguration(),
+			"aws_autoscaling_attachment":       autoscaling.ResourceAttachment(),
+			"aws_autoscaling_group":            autoscaling.ResourceGroup(),
+			"aws_autoscaling_group_tag":        autoscaling.ResourceGroupTag(),
+			"aws_autoscaling_instance_refresh": autoscaling.ResourceInstanceRefresh(),
+			"aws_autoscaling_lifecycle_hook":   autoscaling.ResourceLifecycleHook(),
+			"aws_autoscaling_notification":     autoscaling.ResourceNotification(),
+			"aws_autoscaling_policy":           autoscaling.ResourcePolicy(),
+			"aws_autoscaling_schedule":         autoscaling.ResourceSchedule(),
+			"aws_launch_configuration":         autoscaling.ResourceLaunchConfiguration(),
 
 			"aws_autoscalingplans_scaling_plan": autoscalingplans.ResourceScalingPlan(),
 
@@ -1111,10 +1167,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_cloudcontrolapi_resource": cloudcontrol.ResourceResource(),
 
-			"aws_cloudformation_stack":              cloudformation.ResourceStack(),
-			"aws_cloudformation_stack_set":          cloudformation.ResourceStackSet(),
-			"aws_cloudformation_stack_set_instance": cloudformation.ResourceStackSetInstance(),
-			"aws_cloudformation_type":               cloudformation.ResourceType(),
+			"aws_cloudformation_stack":                     cloudformation.ResourceStack(),
+			"aws_cloudformation_stack_set":                 cloudformation.ResourceStackSet(),
+			"aws_cloudformation_stack_set_drift_detection": cloudformation.ResourceStackSetDriftDetection(),
+			"aws_cloudformation_stack_set_instance":        cloudformation.ResourceStackSetInstance(),
+			"aws_cloudformation_type":                      cloudformation.ResourceType(),
 
 			"aws_cloudfront_cache_policy":                   cloudfront.ResourceCachePolicy(),
 			"aws_cloudfront_distribution":                   cloudfront.ResourceDistribution(),
@@ -1189,16 +1246,17 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_cognito_identity_pool_provider_principal_tag": cognitoidentity.ResourcePoolProviderPrincipalTag(),
 			"aws_cognito_identity_pool_roles_attachment":       cognitoidentity.ResourcePoolRolesAttachment(),
 
-			"aws_cognito_identity_provider":          cognitoidp.ResourceIdentityProvider(),
-			"aws_cognito_resource_server":            cognitoidp.ResourceResourceServer(),
-			"aws_cognito_risk_configuration":         cognitoidp.ResourceRiskConfiguration(),
-			"aws_cognito_user":                       cognitoidp.ResourceUser(),
-			"aws_cognito_user_group":                 cognitoidp.ResourceUserGroup(),
-			"aws_cognito_user_in_group":              cognitoidp.ResourceUserInGroup(),
-			"aws_cognito_user_pool":                  cognitoidp.ResourceUserPool(),
-			"aws_cognito_user_pool_client":           cognitoidp.ResourceUserPoolClient(),
-			"aws_cognito_user_pool_domain":           cognitoidp.ResourceUserPoolDomain(),
-			"aws_cognito_user_pool_ui_customization": cognitoidp.ResourceUserPoolUICustomization(),
+			"aws_cognito_identity_provider":           cognitoidp.ResourceIdentityProvider(),
+			"aws_cognito_resource_server":             cognitoidp.ResourceResourceServer(),
+			"aws_cognito_risk_configuration":          cognitoidp.ResourceRiskConfiguration(),
+			"aws_cognito_user":                        cognitoidp.ResourceUser(),
+			"aws_cognito_user_group":                  cognitoidp.ResourceUserGroup(),
+			"aws_cognito_user_in_group":               cognitoidp.ResourceUserInGroup(),
+			"aws_cognito_user_pool":                   cognitoidp.ResourceUserPool(),
+			"aws_cognito_user_pool_client":            cognitoidp.ResourceUserPoolClient(),
+			"aws_cognito_user_pool_domain":            cognitoidp.ResourceUserPoolDomain(),
+			"aws_cognito_user_pool_ui_customization":  cognitoidp.ResourceUserPoolUICustomization(),
+			"aws_cognito_user_pool_ui_customizations": cognitoidp.ResourceUserPoolUICustomizations(),
 
 			"aws_comprehend_document_classifier": comprehend.ResourceDocumentClassifier(),
 			"aws_comprehend_entity_recognizer":   comprehend.ResourceEntityRecognizer(),
@@ -1291,13 +1349,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_dlm_lifecycle_policy": dlm.ResourceLifecyclePolicy(),
 
-			"aws_dms_certificate":              dms.ResourceCertificate(),
-			"aws_dms_endpoint":                 dms.ResourceEndpoint(),
-			"aws_dms_event_subscription":       dms.ResourceEventSubscription(),
-			"aws_dms_replication_instance":     dms.ResourceReplicationInstance(),
-			"aws_dms_replication_subnet_group": dms.ResourceReplicationSubnetGroup(),
-			"aws_dms_replication_task":         dms.ResourceReplicationTask(),
-			"aws_dms_s3_endpoint":              dms.ResourceS3Endpoint(),
+			"aws_dms_certificate":                     dms.ResourceCertificate(),
+			"aws_dms_endpoint":                        dms.ResourceEndpoint(),
+			"aws_dms_event_subscription":              dms.ResourceEventSubscription(),
+			"aws_dms_replication_instance":            dms.ResourceReplicationInstance(),
+			"aws_dms_replication_subnet_group":        dms.ResourceReplicationSubnetGroup(),
+			"aws_dms_replication_task":                dms.ResourceReplicationTask(),
+			"aws_dms_replication_task_assessment_run": dms.ResourceReplicationTaskAssessmentRun(),
+			"aws_dms_s3_endpoint":                     dms.ResourceS3Endpoint(),
 
 			"aws_docdb_cluster":                 docdb.ResourceCluster(),
 			"aws_docdb_cluster_instance":        docdb.ResourceClusterInstance(),
@@ -1314,6 +1373,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_directory_service_radius_settings":           ds.ResourceRadiusSettings(),
 			"aws_directory_service_shared_directory_accepter": ds.ResourceSharedDirectoryAccepter(),
 			"aws_directory_service_shared_directory":          ds.ResourceSharedDirectory(),
+			"aws_directory_service_settings":                  ds.ResourceSettings(),
+			"aws_directory_service_trust":                     ds.ResourceTrust(),
 
 			"aws_dynamodb_contributor_insights":          dynamodb.ResourceContributorInsights(),
 			"aws_dynamodb_global_table":                  dynamodb.ResourceGlobalTable(),
@@ -1336,6 +1397,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_default_vpc_dhcp_options":                         ec2.ResourceDefaultVPCDHCPOptions(),
 			"aws_ebs_default_kms_key":                              ec2.ResourceEBSDefaultKMSKey(),
 			"aws_ebs_encryption_by_default":                        ec2.ResourceEBSEncryptionByDefault(),
+			"aws_ebs_fast_snapshot_restore":                        ec2.ResourceEBSFastSnapshotRestore(),
 			"aws_ebs_snapshot":                                     ec2.ResourceEBSSnapshot(),
 			"aws_ebs_snapshot_copy":                                ec2.ResourceEBSSnapshotCopy(),
 			"aws_ebs_snapshot_import":                              ec2.ResourceEBSSnapshotImport(),
@@ -1351,6 +1413,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_ec2_host":                                         ec2.ResourceHost(),
 			"aws_ec2_instance_state":                               ec2.ResourceInstanceState(),
 			"aws_ec2_local_gateway_route":                          ec2.ResourceLocalGatewayRoute(),
+			"aws_ec2_local_gateway_route_table":                    ec2.ResourceLocalGatewayRouteTable(),
 			"aws_ec2_local_gateway_route_table_vpc_association":    ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
 			"aws_ec2_managed_prefix_list":                          ec2.ResourceManagedPrefixList(),
 			"aws_ec2_managed_prefix_list_entry":                    ec2.ResourceManagedPrefixListEntry(),
@@ -1421,6 +1484,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_vpc_endpoint_security_group_association":          ec2.ResourceVPCEndpointSecurityGroupAssociation(),
 			"aws_vpc_endpoint_service":                             ec2.ResourceVPCEndpointService(),
 			"aws_vpc_endpoint_service_allowed_principal":           ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
+			"aws_vpc_endpoint_service_private_dns_verification":    ec2.ResourceVPCEndpointServicePrivateDNSVerification(),
 			"aws_vpc_endpoint_subnet_association":                  ec2.ResourceVPCEndpointSubnetAssociation(),
 			"aws_vpc_ipam":                                         ec2.ResourceIPAM(),
 			"aws_vpc_ipam_organization_admin_account":              ec2.ResourceIPAMOrganizationAdminAccount(),
@@ -1435,6 +1499,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_vpc_peering_connection":                           ec2.ResourceVPCPeeringConnection(),
 			"aws_vpc_peering_connection_accepter":                  ec2.ResourceVPCPeeringConnectionAccepter(),
 			"aws_vpc_peering_connection_options":                   ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpc_security_group_rules_exclusive":               ec2.ResourceSecurityGroupRulesExclusive(),
 			"aws_vpn_connection":                                   ec2.ResourceVPNConnection(),
 			"aws_vpn_connection_route":                             ec2.ResourceVPNConnectionRoute(),
 			"aws_vpn_gateway":                                      ec2.ResourceVPNGateway(),
@@ -1527,6 +1592,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_emr_studio":                 emr.ResourceStudio(),
 			"aws_emr_studio_session_mapping": emr.ResourceStudioSessionMapping(),
 
+			"aws_emrcontainers_job_template":    emrcontainers.ResourceJobTemplate(),
 			"aws_emrcontainers_virtual_cluster": emrcontainers.ResourceVirtualCluster(),
 
 			"aws_emrserverless_application": emrserverless.ResourceApplication(),
@@ -1554,12 +1620,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_fsx_openzfs_snapshot":              fsx.ResourceOpenzfsSnapshot(),
 			"aws_fsx_windows_file_system":           fsx.ResourceWindowsFileSystem(),
 
-			"aws_gamelift_alias":              gamelift.ResourceAlias(),
-			"aws_gamelift_build":              gamelift.ResourceBuild(),
-			"aws_gamelift_fleet":              gamelift.ResourceFleet(),
-			"aws_gamelift_game_server_group":  gamelift.ResourceGameServerGroup(),
-			"aws_gamelift_game_session_queue": gamelift.ResourceGameSessionQueue(),
-			"aws_gamelift_script":             gamelift.ResourceScript(),
+			"aws_gamelift_alias":                   gamelift.ResourceAlias(),
+			"aws_gamelift_build":                   gamelift.ResourceBuild(),
+			"aws_gamelift_fleet":                   gamelift.ResourceFleet(),
+			"aws_gamelift_fleet_location_capacity": gamelift.ResourceFleetLocationCapacity(),
+			"aws_gamelift_game_server_group":       gamelift.ResourceGameServerGroup(),
+			"aws_gamelift_game_session_queue":      gamelift.ResourceGameSessionQueue(),
+			"aws_gamelift_script":                  gamelift.ResourceScript(),
 
 			"aws_glacier_vault":      glacier.ResourceVault(),
 			"aws_glacier_vault_lock": glacier.ResourceVaultLock(),
@@ -1630,9 +1697,10 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_iam_user_ssh_key":                iam.ResourceUserSSHKey(),
 			"aws_iam_virtual_mfa_device":          iam.ResourceVirtualMFADevice(),
 
-			"aws_identitystore_group":            identitystore.ResourceGroup(),
-			"aws_identitystore_user":             identitystore.ResourceUser(),
-			"aws_identitystore_group_membership": identitystore.ResourceGroupMembership(),
+			"aws_identitystore_group":                       identitystore.ResourceGroup(),
+			"aws_identitystore_user":                        identitystore.ResourceUser(),
+			"aws_identitystore_group_membership":            identitystore.ResourceGroupMembership(),
+			"aws_identitystore_group_memberships_exclusive": identitystore.ResourceGroupMembershipsExclusive(),
 
 			"aws_imagebuilder_component":                    imagebuilder.ResourceComponent(),
 			"aws_imagebuilder_container_recipe":             imagebuilder.ResourceContainerRecipe(),
@@ -1733,13 +1801,24 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_lex_intent":    lexmodels.ResourceIntent(),
 			"aws_lex_slot_type": lexmodels.ResourceSlotType(),
 
+			"aws_lexv2models_bot":         lexv2models.ResourceBot(),
+			"aws_lexv2models_bot_alias":   lexv2models.ResourceBotAlias(),
+			"aws_lexv2models_bot_locale":  lexv2models.ResourceBotLocale(),
+			"aws_lexv2models_bot_version": lexv2models.ResourceBotVersion(),
+			"aws_lexv2models_intent":      lexv2models.ResourceIntent(),
+			"aws_lexv2models_slot":        lexv2models.ResourceSlot(),
+			"aws_lexv2models_slot_type":   lexv2models.ResourceSlotType(),
+
 			"aws_licensemanager_association":           licensemanager.ResourceAssociation(),
 			"aws_licensemanager_license_configuration": licensemanager.ResourceLicenseConfiguration(),
 
 			"aws_lightsail_bucket":                               lightsail.ResourceBucket(),
+			"aws_lightsail_bucket_access_key":                    lightsail.ResourceBucketAccessKey(),
+			"aws_lightsail_bucket_resource_access":               lightsail.ResourceBucketResourceAccess(),
 			"aws_lightsail_certificate":                          lightsail.ResourceCertificate(),
 			"aws_lightsail_container_service":                    lightsail.ResourceContainerService(),
 			"aws_lightsail_container_service_deployment_version": lightsail.ResourceContainerServiceDeploymentVersion(),
+			"aws_lightsail_container_service_image":              lightsail.ResourceContainerServiceImage(),
 			"aws_lightsail_database":                             lightsail.ResourceDatabase(),
 			"aws_lightsail_disk":                                 lightsail.ResourceDisk(),
 			"aws_lightsail_disk_attachment":                      lightsail.ResourceDiskAttachment(),
@@ -1758,6 +1837,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_lightsail_static_ip_attachment":                 lightsail.ResourceStaticIPAttachment(),
 
 			"aws_location_geofence_collection": location.ResourceGeofenceCollection(),
+			"aws_location_geofences":           location.ResourceGeofences(),
 			"aws_location_map":                 location.ResourceMap(),
 			"aws_location_place_index":         location.ResourcePlaceIndex(),
 			"aws_location_route_calculator":    location.ResourceRouteCalculator(),
@@ -1776,7 +1856,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_macie2_organization_admin_account":          macie2.ResourceOrganizationAdminAccount(),
 			"aws_macie2_classification_export_configuration": macie2.ResourceClassificationExportConfiguration(),
 
-			"aws_media_convert_queue": mediaconvert.ResourceQueue(),
+			"aws_managedblockchain_accessor": managedblockchain.ResourceAccessor(),
+
+			"aws_media_convert_job_template": mediaconvert.ResourceJobTemplate(),
+			"aws_media_convert_preset":       mediaconvert.ResourcePreset(),
+			"aws_media_convert_queue":        mediaconvert.ResourceQueue(),
 
 			"aws_media_package_channel": mediapackage.ResourceChannel(),
 
@@ -1795,6 +1879,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_memorydb_subnet_group":    memorydb.ResourceSubnetGroup(),
 			"aws_memorydb_user":            memorydb.ResourceUser(),
 
+			"aws_mgn_application":                        mgn.ResourceApplication(),
+			"aws_mgn_launch_configuration_template":      mgn.ResourceLaunchConfigurationTemplate(),
+			"aws_mgn_replication_configuration_template": mgn.ResourceReplicationConfigurationTemplate(),
+			"aws_mgn_wave":                               mgn.ResourceWave(),
+
 			"aws_mq_broker":        mq.ResourceBroker(),
 			"aws_mq_configuration": mq.ResourceConfiguration(),
 
@@ -1876,8 +1965,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_pinpoint_gcm_channel":               pinpoint.ResourceGCMChannel(),
 			"aws_pinpoint_sms_channel":               pinpoint.ResourceSMSChannel(),
 
-			"aws_qldb_ledger": qldb.ResourceLedger(),
-			"aws_qldb_stream": qldb.ResourceStream(),
+			"aws_polly_lexicon": polly.ResourceLexicon(),
+
+			"aws_qldb_journal_s3_export": qldb.ResourceJournalS3Export(),
+			"aws_qldb_ledger":            qldb.ResourceLedger(),
+			"aws_qldb_stream":            qldb.ResourceStream(),
 
 			"aws_quicksight_data_source":      quicksight.ResourceDataSource(),
 			"aws_quicksight_group":            quicksight.ResourceGroup(),
@@ -1940,8 +2032,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_redshiftserverless_usage_limit":     redshiftserverless.ResourceUsageLimit(),
 			"aws_redshiftserverless_workgroup":       redshiftserverless.ResourceWorkgroup(),
 
+			"aws_rekognition_project":          rekognition.ResourceProject(),
+			"aws_rekognition_project_version":  rekognition.ResourceProjectVersion(),
+			"aws_rekognition_stream_processor": rekognition.ResourceStreamProcessor(),
+
 			"aws_resourcegroups_group": resourcegroups.ResourceGroup(),
 
+			"aws_rolesanywhere_crl":          rolesanywhere.ResourceCRL(),
 			"aws_rolesanywhere_profile":      rolesanywhere.ResourceProfile(),
 			"aws_rolesanywhere_trust_anchor": rolesanywhere.ResourceTrustAnchor(),
 
@@ -2116,6 +2213,10 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_signer_signing_profile":            signer.ResourceSigningProfile(),
 			"aws_signer_signing_profile_permission": signer.ResourceSigningProfilePermission(),
 
+			"aws_snowball_address": snowball.ResourceAddress(),
+			"aws_snowball_cluster": snowball.ResourceCluster(),
+			"aws_snowball_job":     snowball.ResourceJob(),
+
 			"aws_sns_platform_application": sns.ResourcePlatformApplication(),
 			"aws_sns_sms_preferences":      sns.ResourceSMSPreferences(),
 			"aws_sns_topic":                sns.ResourceTopic(),
@@ -2141,6 +2242,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_ssm_service_setting":           ssm.ResourceServiceSetting(),
 
 			"aws_ssoadmin_account_assignment":                 ssoadmin.ResourceAccountAssignment(),
+			"aws_ssoadmin_account_assignments_exclusive":      ssoadmin.ResourceAccountAssignmentsExclusive(),
 			"aws_ssoadmin_customer_managed_policy_attachment": ssoadmin.ResourceCustomerManagedPolicyAttachment(),
 			"aws_ssoadmin_instance_access_control_attributes": ssoadmin.ResourceAccessControlAttributes(),
 			"aws_ssoadmin_managed_policy_attachment":          ssoadmin.ResourceManagedPolicyAttachment(),
@@ -2163,6 +2265,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_synthetics_canary": synthetics.ResourceCanary(),
 
+			"aws_timestreamquery_scheduled_query": timestreamquery.ResourceScheduledQuery(),
+
 			"aws_timestreamwrite_database": timestreamwrite.ResourceDatabase(),
 			"aws_timestreamwrite_table":    timestreamwrite.ResourceTable(),
 
@@ -2178,6 +2282,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_transfer_user":     transfer.ResourceUser(),
 			"aws_transfer_workflow": transfer.ResourceWorkflow(),
 
+			"aws_translate_parallel_data": translate.ResourceParallelData(),
+
 			"aws_waf_byte_match_set":          waf.ResourceByteMatchSet(),
 			"aws_waf_geo_match_set":           waf.ResourceGeoMatchSet(),
 			"aws_waf_ipset":                   waf.ResourceIPSet(),
@@ -2215,6 +2321,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_worklink_fleet": worklink.ResourceFleet(),
 			"aws_worklink_website_certificate_authority_association": worklink.ResourceWebsiteCertificateAuthorityAssociation(),
 
+			"aws_workmail_domain":             workmail.ResourceDomain(),
+			"aws_workmail_group":              workmail.ResourceGroup(),
+			"aws_workmail_mailbox_permission": workmail.ResourceMailboxPermission(),
+			"aws_workmail_organization":       workmail.ResourceOrganization(),
+			"aws_workmail_resource":           workmail.ResourceResource(),
+			"aws_workmail_user":               workmail.ResourceUser(),
+
 			"aws_workspaces_directory": workspaces.ResourceDirectory(),
 			"aws_workspaces_ip_group":  workspaces.ResourceIPGroup(),
 			"aws_workspaces_workspace": workspaces.ResourceWorkspace(),
@@ -2290,6 +2403,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		}
 	}
 
+	// Protect every tagged resource, both those in the static map and those registered by service packages.
+	for _, r := range provider.ResourcesMap {
+		preventDestroyTagsResource(r)
+	}
+
 	if err := errs.ErrorOrNil(); err != nil {
 		return nil, err
 	}
@@ -2382,6 +2500,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("prevent_destroy_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.PreventDestroyTagsConfig = expandPreventDestroyTags(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("shared_credentials_file"); ok {
 		config.SharedCredentialsFiles = []string{v.(string)}
 	} else if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
@@ -2706,6 +2828,24 @@ func expandIgnoreTags(tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	return ignoreConfig
 }
 
+func expandPreventDestroyTags(tfMap map[string]interface{}) *tftags.PreventDestroyConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	preventDestroyConfig := &tftags.PreventDestroyConfig{}
+
+	if v, ok := tfMap["keys"].(*schema.Set); ok {
+		preventDestroyConfig.Keys = tftags.New(v.List())
+	}
+
+	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
+		preventDestroyConfig.Tags = tftags.New(v)
+	}
+
+	return preventDestroyConfig
+}
+
 func expandEndpoints(tfList []interface{}) (map[string]string, error) {
 	if len(tfList) == 0 {
 		return nil, nil
@@ -2791,6 +2931,45 @@ func wrappedDeleteContextFunc(f schema.DeleteContextFunc) schema.DeleteContextFu
 	}
 }
 
+// preventDestroyTagsResource wraps the resource's delete handler with a check against the provider's prevent_destroy_tags configuration.
+// Resources without a map of tags are left unchanged.
+func preventDestroyTagsResource(r *schema.Resource) {
+	var tagsKey string
+
+	for _, k := range []string{"tags_all", "tags"} {
+		if v, ok := r.Schema[k]; ok && v.Type == schema.TypeMap {
+			tagsKey = k
+			break
+		}
+	}
+
+	if tagsKey == "" {
+		return
+	}
+
+	if v := r.DeleteWithoutTimeout; v != nil {
+		r.DeleteWithoutTimeout = preventDestroyTagsDeleteContextFunc(v, tagsKey)
+	}
+	if v := r.DeleteContext; v != nil {
+		r.DeleteContext = preventDestroyTagsDeleteContextFunc(v, tagsKey)
+	}
+}
+
+// preventDestroyTagsDeleteContextFunc refuses to delete a resource whose tags match the provider's prevent_destroy_tags configuration.
+func preventDestroyTagsDeleteContextFunc(f schema.DeleteContextFunc, tagsKey string) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		config := meta.(*conns.AWSClient).PreventDestroyTagsConfig
+
+		if v, ok := d.Get(tagsKey).(map[string]interface{}); ok && config != nil {
+			if keys := config.ProtectingKeys(tftags.New(v)); len(keys) > 0 {
+				return diag.Errorf("deleting resource (%s): prevented by provider prevent_destroy_tags configuration, matching tag keys: %s", d.Id(), strings.Join(keys, ", "))
+			}
+		}
+
+		return f(ctx, d, meta)
+	}
+}
+
 func wrappedStateContextFunc(f schema.StateContextFunc) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = meta.(*conns.AWSClient).InitContext(ctx)
@@ -6,6 +6,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -25,6 +28,65 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderPreventDestroyTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p, err := New(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meta := &conns.AWSClient{
+		PreventDestroyTagsConfig: &tftags.PreventDestroyConfig{
+			Keys: tftags.New([]string{"protected"}),
+		},
+	}
+
+	testCases := []string{
+		// Registered in the static ResourcesMap.
+		"aws_db_instance",
+		"aws_dynamodb_table",
+		"aws_s3_bucket",
+		// Registered by a service package.
+		"aws_cloudwatch_log_group",
+	}
+
+	for _, typeName := range testCases {
+		typeName := typeName
+
+		t.Run(typeName, func(t *testing.T) {
+			t.Parallel()
+
+			r, ok := p.ResourcesMap[typeName]
+
+			if !ok {
+				t.Fatalf("resource %s not found", typeName)
+			}
+
+			d := r.Data(&terraform.InstanceState{
+				ID: "test",
+				Attributes: map[string]string{
+					"tags_all.%":         "1",
+					"tags_all.protected": "true",
+				},
+			})
+
+			// The check runs before the resource's own delete handler, so no AWS API calls are made.
+			diags := r.DeleteWithoutTimeout(ctx, d, meta)
+
+			if !diags.HasError() {
+				t.Fatal("expected error, got none")
+			}
+
+			if got, want := diags[0].Summary, "prevent_destroy_tags"; !strings.Contains(got, want) {
+				t.Errorf("error %q does not contain %q", got, want)
+			}
+		})
+	}
+}
+
 func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	oldEnv := stashEnv()
 	defer popEnv(oldEnv)
@@ -116,12 +116,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/location"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/logs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
@@ -129,6 +131,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediastore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
@@ -140,6 +143,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/polly"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
@@ -148,6 +152,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourceexplorer2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
@@ -176,6 +181,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/shield"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/signer"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/simpledb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/snowball"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
@@ -184,13 +190,16 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/swf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamquery"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/translate"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/worklink"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/xray"
 	"golang.org/x/exp/slices"
@@ -308,12 +317,14 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		lakeformation.ServicePackage,
 		lambda.ServicePackage,
 		lexmodels.ServicePackage,
+		lexv2models.ServicePackage,
 		licensemanager.ServicePackage,
 		lightsail.ServicePackage,
 		location.ServicePackage,
 		logs.ServicePackage,
 		macie.ServicePackage,
 		macie2.ServicePackage,
+		managedblockchain.ServicePackage,
 		mediaconnect.ServicePackage,
 		mediaconvert.ServicePackage,
 		medialive.ServicePackage,
@@ -321,6 +332,7 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		mediastore.ServicePackage,
 		memorydb.ServicePackage,
 		meta.ServicePackage,
+		mgn.ServicePackage,
 		mq.ServicePackage,
 		mwaa.ServicePackage,
 		neptune.ServicePackage,
@@ -332,6 +344,7 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		organizations.ServicePackage,
 		outposts.ServicePackage,
 		pinpoint.ServicePackage,
+		polly.ServicePackage,
 		pricing.ServicePackage,
 		qldb.ServicePackage,
 		quicksight.ServicePackage,
@@ -340,6 +353,7 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		redshift.ServicePackage,
 		redshiftdata.ServicePackage,
 		redshiftserverless.ServicePackage,
+		rekognition.ServicePackage,
 		resourceexplorer2.ServicePackage,
 		resourcegroups.ServicePackage,
 		resourcegroupstaggingapi.ServicePackage,
@@ -368,6 +382,7 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		shield.ServicePackage,
 		signer.ServicePackage,
 		simpledb.ServicePackage,
+		snowball.ServicePackage,
 		sns.ServicePackage,
 		sqs.ServicePackage,
 		ssm.ServicePackage,
@@ -376,13 +391,16 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		sts.ServicePackage,
 		swf.ServicePackage,
 		synthetics.ServicePackage,
+		timestreamquery.ServicePackage,
 		timestreamwrite.ServicePackage,
 		transcribe.ServicePackage,
 		transfer.ServicePackage,
+		translate.ServicePackage,
 		waf.ServicePackage,
 		wafregional.ServicePackage,
 		wafv2.ServicePackage,
 		worklink.ServicePackage,
+		workmail.ServicePackage,
 		workspaces.ServicePackage,
 		xray.ServicePackage,
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -73,12 +74,12 @@ func ResourceMethodSettings() *schema.Resource {
 						"throttling_burst_limit": {
 							Type:     schema.TypeInt,
 							Optional: true,
-							Default:  -1,
+							Computed: true,
 						},
 						"throttling_rate_limit": {
 							Type:     schema.TypeFloat,
 							Optional: true,
-							Default:  -1,
+							Computed: true,
 						},
 						"caching_enabled": {
 							Type:     schema.TypeBool,
@@ -196,18 +197,37 @@ func resourceMethodSettingsUpdate(ctx context.Context, d *schema.ResourceData, m
 		})
 	}
 
-	if d.HasChange("settings.0.throttling_burst_limit") {
+	// Throttling is disabled (-1) unless configured. Unconfigured limits are not planned,
+	// so values inherited from the stage do not show as drift, but a limit that has been
+	// removed from configuration is disabled again when the settings are updated.
+	if methodSettingsConfigured(d, "throttling_burst_limit") {
+		if d.IsNewResource() || d.HasChange("settings.0.throttling_burst_limit") {
+			ops = append(ops, &apigateway.PatchOperation{
+				Op:    aws.String(apigateway.OpReplace),
+				Path:  aws.String(prefix + "throttling/burstLimit"),
+				Value: aws.String(fmt.Sprintf("%d", d.Get("settings.0.throttling_burst_limit").(int))),
+			})
+		}
+	} else if d.IsNewResource() || d.Get("settings.0.throttling_burst_limit").(int) != -1 {
 		ops = append(ops, &apigateway.PatchOperation{
 			Op:    aws.String(apigateway.OpReplace),
 			Path:  aws.String(prefix + "throttling/burstLimit"),
-			Value: aws.String(fmt.Sprintf("%d", d.Get("settings.0.throttling_burst_limit").(int))),
+			Value: aws.String("-1"),
 		})
 	}
-	if d.HasChange("settings.0.throttling_rate_limit") {
+	if methodSettingsConfigured(d, "throttling_rate_limit") {
+		if d.IsNewResource() || d.HasChange("settings.0.throttling_rate_limit") {
+			ops = append(ops, &apigateway.PatchOperation{
+				Op:    aws.String(apigateway.OpReplace),
+				Path:  aws.String(prefix + "throttling/rateLimit"),
+				Value: aws.String(fmt.Sprintf("%f", d.Get("settings.0.throttling_rate_limit").(float64))),
+			})
+		}
+	} else if d.IsNewResource() || d.Get("settings.0.throttling_rate_limit").(float64) != -1 {
 		ops = append(ops, &apigateway.PatchOperation{
 			Op:    aws.String(apigateway.OpReplace),
 			Path:  aws.String(prefix + "throttling/rateLimit"),
-			Value: aws.String(fmt.Sprintf("%f", d.Get("settings.0.throttling_rate_limit").(float64))),
+			Value: aws.String("-1"),
 		})
 	}
 	if d.HasChange("settings.0.caching_enabled") {
@@ -268,6 +288,19 @@ func resourceMethodSettingsUpdate(ctx context.Context, d *schema.ResourceData, m
 	return append(diags, resourceMethodSettingsRead(ctx, d, meta)...)
 }
 
+// methodSettingsConfigured returns whether the specified settings attribute is set in configuration.
+func methodSettingsConfigured(d *schema.ResourceData, key string) bool {
+	v := d.GetRawConfig().GetAttr("settings")
+
+	if !v.IsKnown() || v.IsNull() || v.LengthInt() == 0 {
+		return false
+	}
+
+	v = v.Index(cty.NumberIntVal(0)).GetAttr(key)
+
+	return v.IsKnown() && !v.IsNull()
+}
+
 func resourceMethodSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).APIGatewayConn()
@@ -0,0 +1,184 @@
+package apigateway
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceUsagePlanKeys() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUsagePlanKeysCreate,
+		ReadWithoutTimeout:   resourceUsagePlanKeysRead,
+		UpdateWithoutTimeout: resourceUsagePlanKeysUpdate,
+		DeleteWithoutTimeout: resourceUsagePlanKeysDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"usage_plan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUsagePlanKeysCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
+
+	usagePlanID := d.Get("usage_plan_id").(string)
+
+	// Remove any keys that are attached to the usage plan but not configured.
+	keys, err := FindUsagePlanKeysByUsagePlanID(ctx, conn, usagePlanID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway Usage Plan (%s) Keys: %s", usagePlanID, err)
+	}
+
+	existing := make(map[string]struct{}, len(keys))
+	for _, v := range keys {
+		existing[aws.StringValue(v.Id)] = struct{}{}
+	}
+
+	configured := d.Get("key_ids").(*schema.Set)
+	var add, del []string
+
+	for _, v := range flex.ExpandStringValueSet(configured) {
+		if _, ok := existing[v]; !ok {
+			add = append(add, v)
+		}
+	}
+
+	for v := range existing {
+		if !configured.Contains(v) {
+			del = append(del, v)
+		}
+	}
+
+	if err := updateUsagePlanKeys(ctx, conn, usagePlanID, add, del); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating API Gateway Usage Plan (%s) Keys: %s", usagePlanID, err)
+	}
+
+	d.SetId(usagePlanID)
+
+	return append(diags, resourceUsagePlanKeysRead(ctx, d, meta)...)
+}
+
+func resourceUsagePlanKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
+
+	keys, err := FindUsagePlanKeysByUsagePlanID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] API Gateway Usage Plan (%s) not found, removing Keys from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway Usage Plan (%s) Keys: %s", d.Id(), err)
+	}
+
+	keyIDs := make([]string, 0, len(keys))
+	for _, v := range keys {
+		keyIDs = append(keyIDs, aws.StringValue(v.Id))
+	}
+
+	d.Set("key_ids", keyIDs)
+	d.Set("usage_plan_id", d.Id())
+
+	return diags
+}
+
+func resourceUsagePlanKeysUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
+
+	if d.HasChange("key_ids") {
+		o, n := d.GetChange("key_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+
+		if err := updateUsagePlanKeys(ctx, conn, d.Id(), add, del); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating API Gateway Usage Plan (%s) Keys: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceUsagePlanKeysRead(ctx, d, meta)...)
+}
+
+func resourceUsagePlanKeysDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
+
+	log.Printf("[DEBUG] Deleting API Gateway Usage Plan Keys: %s", d.Id())
+	err := updateUsagePlanKeys(ctx, conn, d.Id(), nil, flex.ExpandStringValueSet(d.Get("key_ids").(*schema.Set)))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting API Gateway Usage Plan (%s) Keys: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// updateUsagePlanKeys detaches and then attaches the specified API keys, one request per key.
+// Requests are retried while API Gateway throttles them. Keys already removed from the usage plan are ignored.
+func updateUsagePlanKeys(ctx context.Context, conn *apigateway.APIGateway, usagePlanID string, add, del []string) error {
+	for _, keyID := range del {
+		input := &apigateway.DeleteUsagePlanKeyInput{
+			KeyId:       aws.String(keyID),
+			UsagePlanId: aws.String(usagePlanID),
+		}
+
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
+			return conn.DeleteUsagePlanKeyWithContext(ctx, input)
+		}, apigateway.ErrCodeTooManyRequestsException)
+
+		if tfawserr.ErrCodeEquals(err, apigateway.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, keyID := range add {
+		input := &apigateway.CreateUsagePlanKeyInput{
+			KeyId:       aws.String(keyID),
+			KeyType:     aws.String("API_KEY"),
+			UsagePlanId: aws.String(usagePlanID),
+		}
+
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
+			return conn.CreateUsagePlanKeyWithContext(ctx, input)
+		}, apigateway.ErrCodeTooManyRequestsException)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
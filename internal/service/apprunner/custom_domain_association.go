@@ -121,7 +121,7 @@ func resourceCustomDomainAssociationRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	customDomain, err := FindCustomDomain(ctx, conn, domainName, serviceArn)
+	customDomain, dnsTarget, err := FindCustomDomainAndDNSTarget(ctx, conn, domainName, serviceArn)
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, apprunner.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] App Runner Custom Domain Association (%s) not found, removing from state", d.Id())
@@ -142,6 +142,7 @@ func resourceCustomDomainAssociationRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(fmt.Errorf("error setting certificate_validation_records: %w", err))
 	}
 
+	d.Set("dns_target", dnsTarget)
 	d.Set("domain_name", customDomain.DomainName)
 	d.Set("enable_www_subdomain", customDomain.EnableWWWSubdomain)
 	d.Set("service_arn", serviceArn)
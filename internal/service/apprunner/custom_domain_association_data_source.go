@@ -0,0 +1,98 @@
+package apprunner
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceCustomDomainAssociation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCustomDomainAssociationRead,
+
+		Schema: map[string]*schema.Schema{
+			"certificate_validation_records": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"dns_target": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"enable_www_subdomain": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"service_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCustomDomainAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerConn()
+
+	domainName := d.Get("domain_name").(string)
+	serviceArn := d.Get("service_arn").(string)
+
+	customDomain, dnsTarget, err := FindCustomDomainAndDNSTarget(ctx, conn, domainName, serviceArn)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading App Runner Custom Domain (%s) for Service (%s): %w", domainName, serviceArn, err))
+	}
+
+	if customDomain == nil {
+		return diag.FromErr(fmt.Errorf("error reading App Runner Custom Domain (%s) for Service (%s): not found", domainName, serviceArn))
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", domainName, serviceArn))
+
+	if err := d.Set("certificate_validation_records", flattenCustomDomainCertificateValidationRecords(customDomain.CertificateValidationRecords)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting certificate_validation_records: %w", err))
+	}
+
+	d.Set("dns_target", dnsTarget)
+	d.Set("domain_name", customDomain.DomainName)
+	d.Set("enable_www_subdomain", customDomain.EnableWWWSubdomain)
+	d.Set("service_arn", serviceArn)
+	d.Set("status", customDomain.Status)
+
+	return nil
+}
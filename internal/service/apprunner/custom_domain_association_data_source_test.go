@@ -0,0 +1,52 @@
+package apprunner_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAppRunnerCustomDomainAssociationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	domain := os.Getenv("APPRUNNER_CUSTOM_DOMAIN")
+	if domain == "" {
+		t.Skip("Environment variable APPRUNNER_CUSTOM_DOMAIN is not set")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_apprunner_custom_domain_association.test"
+	resourceName := "aws_apprunner_custom_domain_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apprunner.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomDomainAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDomainAssociationDataSourceConfig_basic(rName, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "certificate_validation_records.#", resourceName, "certificate_validation_records.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "dns_target", resourceName, "dns_target"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_name", resourceName, "domain_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "enable_www_subdomain", resourceName, "enable_www_subdomain"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_arn", resourceName, "service_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "status", resourceName, "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomDomainAssociationDataSourceConfig_basic(rName, domain string) string {
+	return acctest.ConfigCompose(testAccCustomDomainAssociationConfig_basic(rName, domain), `
+data "aws_apprunner_custom_domain_association" "test" {
+  domain_name = aws_apprunner_custom_domain_association.test.domain_name
+  service_arn = aws_apprunner_custom_domain_association.test.service_arn
+}
+`)
+}
@@ -80,3 +80,45 @@ func FindCustomDomain(ctx context.Context, conn *apprunner.AppRunner, domainName
 
 	return customDomain, nil
 }
+
+// FindCustomDomainAndDNSTarget returns the custom domain along with the service's DNS target,
+// which DescribeCustomDomains reports once per service rather than per domain.
+func FindCustomDomainAndDNSTarget(ctx context.Context, conn *apprunner.AppRunner, domainName, serviceArn string) (*apprunner.CustomDomain, string, error) {
+	input := &apprunner.DescribeCustomDomainsInput{
+		ServiceArn: aws.String(serviceArn),
+	}
+
+	var customDomain *apprunner.CustomDomain
+	var dnsTarget string
+
+	err := conn.DescribeCustomDomainsPagesWithContext(ctx, input, func(page *apprunner.DescribeCustomDomainsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		dnsTarget = aws.StringValue(page.DNSTarget)
+
+		for _, cd := range page.CustomDomains {
+			if cd == nil {
+				continue
+			}
+
+			if aws.StringValue(cd.DomainName) == domainName {
+				customDomain = cd
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	if customDomain == nil {
+		return nil, "", nil
+	}
+
+	return customDomain, dnsTarget, nil
+}
@@ -1283,7 +1283,7 @@ func TestAccAutoScalingGroup_warmPool(t *testing.T) {
 			},
 			testAccGroupImportStep(resourceName),
 			{
-				Config: testAccGroupConfig_warmPoolFull(rName),
+				Config: testAccGroupConfig_warmPoolFull(rName, true),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckGroupExists(ctx, resourceName, &group),
 					resource.TestCheckResourceAttr(resourceName, "warm_pool.#", "1"),
@@ -1294,6 +1294,18 @@ func TestAccAutoScalingGroup_warmPool(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "warm_pool.0.pool_state", "Stopped"),
 				),
 			},
+			{
+				Config: testAccGroupConfig_warmPoolFull(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "warm_pool.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "warm_pool.0.instance_reuse_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "warm_pool.0.instance_reuse_policy.0.reuse_on_scale_in", "false"),
+					resource.TestCheckResourceAttr(resourceName, "warm_pool.0.max_group_prepared_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "warm_pool.0.min_size", "0"),
+					resource.TestCheckResourceAttr(resourceName, "warm_pool.0.pool_state", "Stopped"),
+				),
+			},
 			{
 				Config: testAccGroupConfig_warmPoolNone(rName),
 				Check: resource.ComposeTestCheckFunc(
@@ -4567,7 +4579,7 @@ resource "aws_autoscaling_group" "test" {
 `, rName))
 }
 
-func testAccGroupConfig_warmPoolFull(rName string) string {
+func testAccGroupConfig_warmPoolFull(rName string, reuseOnScaleIn bool) string {
 	return acctest.ConfigCompose(testAccGroupConfig_launchConfigurationBase(rName, "t3.nano"), fmt.Sprintf(`
 resource "aws_autoscaling_group" "test" {
   availability_zones   = [data.aws_availability_zones.available.names[0]]
@@ -4582,7 +4594,7 @@ resource "aws_autoscaling_group" "test" {
     min_size                    = 0
     max_group_prepared_capacity = 2
     instance_reuse_policy {
-      reuse_on_scale_in = true
+      reuse_on_scale_in = %[2]t
     }
   }
 
@@ -0,0 +1,291 @@
+package autoscaling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceInstanceRefresh() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInstanceRefreshCreate,
+		ReadWithoutTimeout:   resourceInstanceRefreshRead,
+		DeleteWithoutTimeout: resourceInstanceRefreshDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(instanceRefreshCancelledTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"autoscaling_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_refresh_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"percentage_complete": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"preferences": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"checkpoint_delay": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableIntAtLeast(0),
+						},
+						"checkpoint_percentages": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"instance_warmup": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableIntAtLeast(0),
+						},
+						"min_healthy_percentage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      90,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"skip_matching": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      autoscaling.RefreshStrategyRolling,
+				ValidateFunc: validation.StringInSlice(autoscaling.RefreshStrategy_Values(), false),
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceInstanceRefreshCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
+
+	asgName := d.Get("autoscaling_group_name").(string)
+	input := &autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(asgName),
+		Strategy:             aws.String(d.Get("strategy").(string)),
+	}
+
+	if v, ok := d.GetOk("preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Preferences = expandRefreshPreferences(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Starting Auto Scaling Group (%s) instance refresh: %s", asgName, input)
+	output, err := conn.StartInstanceRefreshWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Auto Scaling Group (%s) instance refresh: %s", asgName, err)
+	}
+
+	d.SetId(InstanceRefreshCreateResourceID(asgName, aws.StringValue(output.InstanceRefreshId)))
+
+	if _, err := waitInstanceRefreshSuccessful(ctx, conn, asgName, aws.StringValue(output.InstanceRefreshId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Auto Scaling Group (%s) instance refresh (%s) complete: %s", asgName, aws.StringValue(output.InstanceRefreshId), err)
+	}
+
+	return append(diags, resourceInstanceRefreshRead(ctx, d, meta)...)
+}
+
+func resourceInstanceRefreshRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
+
+	asgName, refreshID, err := InstanceRefreshParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Auto Scaling Group instance refresh (%s): %s", d.Id(), err)
+	}
+
+	refresh, err := FindInstanceRefreshByTwoPartKey(ctx, conn, asgName, refreshID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Auto Scaling Group instance refresh %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Auto Scaling Group instance refresh (%s): %s", d.Id(), err)
+	}
+
+	d.Set("autoscaling_group_name", refresh.AutoScalingGroupName)
+	d.Set("instance_refresh_id", refresh.InstanceRefreshId)
+	d.Set("percentage_complete", refresh.PercentageComplete)
+	d.Set("status", refresh.Status)
+	d.Set("status_reason", refresh.StatusReason)
+
+	return diags
+}
+
+func resourceInstanceRefreshDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
+
+	asgName, refreshID, err := InstanceRefreshParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Auto Scaling Group instance refresh (%s): %s", d.Id(), err)
+	}
+
+	refresh, err := FindInstanceRefreshByTwoPartKey(ctx, conn, asgName, refreshID)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Auto Scaling Group instance refresh (%s): %s", d.Id(), err)
+	}
+
+	// Completed instance refreshes cannot be removed, only one that is still active can be cancelled.
+	switch aws.StringValue(refresh.Status) {
+	case autoscaling.InstanceRefreshStatusPending, autoscaling.InstanceRefreshStatusInProgress:
+	default:
+		return diags
+	}
+
+	log.Printf("[DEBUG] Cancelling Auto Scaling Group instance refresh: %s", d.Id())
+	_, err = conn.CancelInstanceRefreshWithContext(ctx, &autoscaling.CancelInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(asgName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, autoscaling.ErrCodeActiveInstanceRefreshNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling Auto Scaling Group instance refresh (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitInstanceRefreshCancelled(ctx, conn, asgName, refreshID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Auto Scaling Group instance refresh (%s) cancel: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const instanceRefreshResourceIDSeparator = ","
+
+func InstanceRefreshCreateResourceID(asgName, refreshID string) string {
+	parts := []string{asgName, refreshID}
+	id := strings.Join(parts, instanceRefreshResourceIDSeparator)
+
+	return id
+}
+
+func InstanceRefreshParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, instanceRefreshResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected AUTOSCALING_GROUP_NAME%[2]sINSTANCE_REFRESH_ID", id, instanceRefreshResourceIDSeparator)
+}
+
+func FindInstanceRefreshByTwoPartKey(ctx context.Context, conn *autoscaling.AutoScaling, asgName, refreshID string) (*autoscaling.InstanceRefresh, error) {
+	input := &autoscaling.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: aws.String(asgName),
+		InstanceRefreshIds:   aws.StringSlice([]string{refreshID}),
+	}
+
+	output, err := findInstanceRefresh(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.InstanceRefreshId) != refreshID {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func waitInstanceRefreshSuccessful(ctx context.Context, conn *autoscaling.AutoScaling, name, id string, timeout time.Duration) (*autoscaling.InstanceRefresh, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			autoscaling.InstanceRefreshStatusInProgress,
+			autoscaling.InstanceRefreshStatusPending,
+		},
+		Target:  []string{autoscaling.InstanceRefreshStatusSuccessful},
+		Refresh: statusInstanceRefresh(ctx, conn, name, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
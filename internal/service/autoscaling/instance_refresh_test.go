@@ -0,0 +1,122 @@
+package autoscaling_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
+)
+
+func TestAccAutoScalingInstanceRefresh_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v autoscaling.InstanceRefresh
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_autoscaling_instance_refresh.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Completed instance refreshes remain in the Auto Scaling Group's history.
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceRefreshConfig_basic(rName, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceRefreshExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "autoscaling_group_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_refresh_id"),
+					resource.TestCheckResourceAttr(resourceName, "percentage_complete", "100"),
+					resource.TestCheckResourceAttr(resourceName, "preferences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "preferences.0.min_healthy_percentage", "0"),
+					resource.TestCheckResourceAttr(resourceName, "preferences.0.skip_matching", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", autoscaling.InstanceRefreshStatusSuccessful),
+					resource.TestCheckResourceAttr(resourceName, "strategy", autoscaling.RefreshStrategyRolling),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.revision", "one"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"preferences", "strategy", "triggers"},
+			},
+			{
+				Config: testAccInstanceRefreshConfig_basic(rName, "two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceRefreshExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", autoscaling.InstanceRefreshStatusSuccessful),
+					resource.TestCheckResourceAttr(resourceName, "triggers.revision", "two"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceRefreshExists(ctx context.Context, n string, v *autoscaling.InstanceRefresh) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Auto Scaling Group instance refresh ID is set")
+		}
+
+		asgName, refreshID, err := tfautoscaling.InstanceRefreshParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
+
+		output, err := tfautoscaling.FindInstanceRefreshByTwoPartKey(ctx, conn, asgName, refreshID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccInstanceRefreshConfig_basic(rName, revision string) string {
+	return acctest.ConfigCompose(testAccGroupConfig_launchTemplateBase(rName, "t3.nano"), fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones = [data.aws_availability_zones.available.names[0]]
+  desired_capacity   = 1
+  max_size           = 1
+  min_size           = 1
+  name               = %[1]q
+
+  launch_template {
+    id      = aws_launch_template.test.id
+    version = aws_launch_template.test.default_version
+  }
+}
+
+resource "aws_autoscaling_instance_refresh" "test" {
+  autoscaling_group_name = aws_autoscaling_group.test.name
+
+  preferences {
+    min_healthy_percentage = 0
+  }
+
+  triggers = {
+    revision = %[2]q
+  }
+}
+`, rName, revision))
+}
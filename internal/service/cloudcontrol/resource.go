@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -32,6 +33,10 @@ func ResourceResource() *schema.Resource {
 		ReadWithoutTimeout:   resourceResourceRead,
 		UpdateWithoutTimeout: resourceResourceUpdate,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceResourceImport,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Hour),
 			Delete: schema.DefaultTimeout(2 * time.Hour),
@@ -226,6 +231,40 @@ func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return nil
 }
 
+func resourceResourceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	typeName, resourceID, err := resourceParseImportID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	resourceDescription, err := FindResource(ctx, meta.(*conns.AWSClient).CloudControlClient(), resourceID, typeName, "", "")
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Cloud Control API (%s) Resource (%s): %w", typeName, resourceID, err)
+	}
+
+	output, err := tfcloudformation.FindTypeByName(ctx, meta.(*conns.AWSClient).CloudFormationConn(), typeName)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading CloudFormation Type (%s): %w", typeName, err)
+	}
+
+	resourceSchema := aws.ToString(output.Schema)
+	desiredState, err := desiredStateFromProperties(aws.ToString(resourceDescription.Properties), resourceSchema)
+
+	if err != nil {
+		return nil, fmt.Errorf("building Cloud Control API (%s) Resource (%s) desired_state: %w", typeName, resourceID, err)
+	}
+
+	d.SetId(resourceID)
+	d.Set("desired_state", desiredState)
+	d.Set("schema", resourceSchema)
+	d.Set("type_name", typeName)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceResourceCustomizeDiffGetSchema(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CloudFormationConn()
 
@@ -430,3 +469,49 @@ func patchDocument(old, new string) (string, error) {
 
 	return string(b), nil
 }
+
+const resourceImportIDSeparator = ","
+
+// resourceParseImportID parses an import ID of the form TYPENAME,IDENTIFIER.
+// The identifier may itself contain the separator, e.g. for composite primary identifiers.
+func resourceParseImportID(id string) (string, string, error) {
+	parts := strings.SplitN(id, resourceImportIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TYPENAME%[2]sIDENTIFIER", id, resourceImportIDSeparator)
+}
+
+// desiredStateFromProperties returns the resource properties with any top-level read-only properties,
+// as declared in the CloudFormation resource type schema, removed.
+func desiredStateFromProperties(properties, resourceSchema string) (string, error) {
+	var s struct {
+		ReadOnlyProperties []string `json:"readOnlyProperties"`
+	}
+
+	if err := json.Unmarshal([]byte(resourceSchema), &s); err != nil {
+		return "", fmt.Errorf("parsing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	var m map[string]interface{}
+
+	if err := json.Unmarshal([]byte(properties), &m); err != nil {
+		return "", fmt.Errorf("parsing properties JSON: %w", err)
+	}
+
+	for _, path := range s.ReadOnlyProperties {
+		if name := strings.TrimPrefix(path, "/properties/"); name != path && !strings.Contains(name, "/") {
+			delete(m, name)
+		}
+	}
+
+	b, err := json.Marshal(m)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
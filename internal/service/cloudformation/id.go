@@ -23,3 +23,22 @@ func StackSetInstanceParseResourceID(id string) (string, string, string, error)
 
 	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected STACKSETNAME%[2]sACCOUNTID%[2]sREGION", id, stackSetInstanceResourceIDSeparator)
 }
+
+const stackSetDriftDetectionResourceIDSeparator = ","
+
+func StackSetDriftDetectionCreateResourceID(stackSetName, operationID string) string {
+	parts := []string{stackSetName, operationID}
+	id := strings.Join(parts, stackSetDriftDetectionResourceIDSeparator)
+
+	return id
+}
+
+func StackSetDriftDetectionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, stackSetDriftDetectionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected STACKSETNAME%[2]sOPERATIONID", id, stackSetDriftDetectionResourceIDSeparator)
+}
@@ -94,6 +94,21 @@ func ResourceStackSet() *schema.Resource {
 				Computed:      true,
 				ConflictsWith: []string{"auto_deployment"},
 			},
+			"managed_execution": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -219,6 +234,10 @@ func resourceStackSetCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.ExecutionRoleName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("managed_execution"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ManagedExecution = expandManagedExecution(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("parameters"); ok {
 		input.Parameters = expandParameters(v.(map[string]interface{}))
 	}
@@ -287,6 +306,15 @@ func resourceStackSetRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.Set("description", stackSet.Description)
 	d.Set("execution_role_name", stackSet.ExecutionRoleName)
+
+	if stackSet.ManagedExecution != nil {
+		if err := d.Set("managed_execution", []interface{}{flattenManagedExecution(stackSet.ManagedExecution)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting managed_execution: %s", err)
+		}
+	} else {
+		d.Set("managed_execution", nil)
+	}
+
 	d.Set("name", stackSet.StackSetName)
 	d.Set("permission_model", stackSet.PermissionModel)
 
@@ -345,6 +373,10 @@ func resourceStackSetUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		input.OperationPreferences = expandOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("managed_execution"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ManagedExecution = expandManagedExecution(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("parameters"); ok {
 		input.Parameters = expandParameters(v.(map[string]interface{}))
 	}
@@ -445,3 +477,31 @@ func flattenStackSetAutoDeploymentResponse(autoDeployment *cloudformation.AutoDe
 
 	return []map[string]interface{}{m}
 }
+
+func expandManagedExecution(tfMap map[string]interface{}) *cloudformation.ManagedExecution {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudformation.ManagedExecution{}
+
+	if v, ok := tfMap["active"].(bool); ok {
+		apiObject.Active = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenManagedExecution(apiObject *cloudformation.ManagedExecution) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Active; v != nil {
+		tfMap["active"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
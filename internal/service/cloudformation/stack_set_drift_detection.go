@@ -0,0 +1,220 @@
+package cloudformation
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceStackSetDriftDetection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStackSetDriftDetectionCreate,
+		ReadWithoutTimeout:   resourceStackSetDriftDetectionRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(StackSetDriftDetectionCreatedDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"call_as": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cloudformation.CallAs_Values(), false),
+				Default:      cloudformation.CallAsSelf,
+			},
+			"drift_detection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drift_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drifted_stack_instances_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"failed_stack_instances_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"in_progress_stack_instances_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"in_sync_stack_instances_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_drift_check_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operation_preferences": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_tolerance_count": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ForceNew:      true,
+							ValidateFunc:  validation.IntAtLeast(0),
+							ConflictsWith: []string{"operation_preferences.0.failure_tolerance_percentage"},
+						},
+						"failure_tolerance_percentage": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ForceNew:      true,
+							ValidateFunc:  validation.IntBetween(0, 100),
+							ConflictsWith: []string{"operation_preferences.0.failure_tolerance_count"},
+						},
+						"max_concurrent_count": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ForceNew:      true,
+							ValidateFunc:  validation.IntAtLeast(1),
+							ConflictsWith: []string{"operation_preferences.0.max_concurrent_percentage"},
+						},
+						"max_concurrent_percentage": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ForceNew:      true,
+							ValidateFunc:  validation.IntBetween(1, 100),
+							ConflictsWith: []string{"operation_preferences.0.max_concurrent_count"},
+						},
+						"region_concurrency_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(cloudformation.RegionConcurrencyType_Values(), false),
+						},
+						"region_order": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]{1,128}$`), ""),
+							},
+						},
+					},
+				},
+			},
+			"stack_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"total_stack_instances_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceStackSetDriftDetectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFormationConn()
+
+	stackSetName := d.Get("stack_set_name").(string)
+	callAs := d.Get("call_as").(string)
+	input := &cloudformation.DetectStackSetDriftInput{
+		OperationId:  aws.String(resource.UniqueId()),
+		StackSetName: aws.String(stackSetName),
+	}
+
+	if callAs != "" {
+		input.CallAs = aws.String(callAs)
+	}
+
+	if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OperationPreferences = expandOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Detecting CloudFormation StackSet drift: %s", input)
+	output, err := conn.DetectStackSetDriftWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "detecting CloudFormation StackSet (%s) drift: %s", stackSetName, err)
+	}
+
+	operationID := aws.StringValue(output.OperationId)
+	d.SetId(StackSetDriftDetectionCreateResourceID(stackSetName, operationID))
+
+	if _, err := WaitStackSetOperationSucceeded(ctx, conn, stackSetName, operationID, callAs, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudFormation StackSet (%s) drift detection (%s): %s", stackSetName, operationID, err)
+	}
+
+	return append(diags, resourceStackSetDriftDetectionRead(ctx, d, meta)...)
+}
+
+func resourceStackSetDriftDetectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFormationConn()
+
+	stackSetName, operationID, err := StackSetDriftDetectionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFormation StackSet drift detection (%s): %s", d.Id(), err)
+	}
+
+	operation, err := FindStackSetOperationByStackSetNameAndOperationID(ctx, conn, stackSetName, operationID, d.Get("call_as").(string))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFormation StackSet drift detection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFormation StackSet drift detection (%s): %s", d.Id(), err)
+	}
+
+	d.Set("operation_id", operation.OperationId)
+	d.Set("stack_set_name", stackSetName)
+
+	if v := operation.StackSetDriftDetectionDetails; v != nil {
+		d.Set("drift_detection_status", v.DriftDetectionStatus)
+		d.Set("drift_status", v.DriftStatus)
+		d.Set("drifted_stack_instances_count", v.DriftedStackInstancesCount)
+		d.Set("failed_stack_instances_count", v.FailedStackInstancesCount)
+		d.Set("in_progress_stack_instances_count", v.InProgressStackInstancesCount)
+		d.Set("in_sync_stack_instances_count", v.InSyncStackInstancesCount)
+		if v.LastDriftCheckTimestamp != nil {
+			d.Set("last_drift_check_timestamp", aws.TimeValue(v.LastDriftCheckTimestamp).Format(time.RFC3339))
+		} else {
+			d.Set("last_drift_check_timestamp", nil)
+		}
+		d.Set("total_stack_instances_count", v.TotalStackInstancesCount)
+	}
+
+	return diags
+}
@@ -0,0 +1,59 @@
+package cloudformation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudFormationStackSetDriftDetection_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	cloudformationStackSetResourceName := "aws_cloudformation_stack_set.test"
+	resourceName := "aws_cloudformation_stack_set_drift_detection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckStackSet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackSetInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackSetDriftDetectionConfig_basic(rName, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "drift_detection_status", cloudformation.StackSetDriftDetectionStatusCompleted),
+					resource.TestCheckResourceAttr(resourceName, "drift_status", cloudformation.StackSetDriftStatusInSync),
+					resource.TestCheckResourceAttr(resourceName, "drifted_stack_instances_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "in_sync_stack_instances_count", "1"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "last_drift_check_timestamp"),
+					resource.TestCheckResourceAttrSet(resourceName, "operation_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "stack_set_name", cloudformationStackSetResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "total_stack_instances_count", "1"),
+				),
+			},
+			{
+				Config: testAccStackSetDriftDetectionConfig_basic(rName, "two"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "drift_status", cloudformation.StackSetDriftStatusInSync),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "two"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStackSetDriftDetectionConfig_basic(rName, run string) string {
+	return acctest.ConfigCompose(testAccStackSetInstanceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_cloudformation_stack_set_drift_detection" "test" {
+  stack_set_name = aws_cloudformation_stack_set_instance.test.stack_set_name
+
+  triggers = {
+    run = %[1]q
+  }
+}
+`, run))
+}
@@ -54,6 +54,9 @@ const (
 const (
 	// Default maximum amount of time to wait for a StackSet to be Updated
 	StackSetUpdatedDefaultTimeout = 30 * time.Minute
+
+	// Default maximum amount of time to wait for StackSet drift detection to complete
+	StackSetDriftDetectionCreatedDefaultTimeout = 30 * time.Minute
 )
 
 func WaitStackSetOperationSucceeded(ctx context.Context, conn *cloudformation.CloudFormation, stackSetName, operationID, callAs string, timeout time.Duration) (*cloudformation.StackSetOperation, error) {
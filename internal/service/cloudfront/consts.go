@@ -1,8 +1,16 @@
 package cloudfront
 
+import (
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+)
+
 const (
 	StreamTypeKinesis = "Kinesis"
 
+	// Origin access control origin types not yet defined by the AWS SDK.
+	OriginAccessControlOriginTypesLambda         = "lambda"
+	OriginAccessControlOriginTypesMediaPackageV2 = "mediapackagev2"
+
 	ResNameDistribution         = "Distribution"
 	ResNamePublicKey            = "Public Key"
 	ResNameOriginAccessIdentity = "Origin Access Identity"
@@ -13,3 +21,10 @@ func StreamType_Values() []string {
 		StreamTypeKinesis,
 	}
 }
+
+func OriginAccessControlOriginTypes_Values() []string {
+	return append(cloudfront.OriginAccessControlOriginTypes_Values(),
+		OriginAccessControlOriginTypesLambda,
+		OriginAccessControlOriginTypesMediaPackageV2,
+	)
+}
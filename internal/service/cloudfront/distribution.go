@@ -2,14 +2,15 @@ package cloudfront
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -823,7 +824,10 @@ func ResourceDistribution() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceDistributionCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -922,12 +926,7 @@ func resourceDistributionRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("arn", resp.Distribution.ARN)
 
 	// override hosted_zone_id from flattenDistributionConfig
-	region := meta.(*conns.AWSClient).Region
-	if v, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok && v.ID() == endpoints.AwsCnPartitionID {
-		d.Set("hosted_zone_id", cnRoute53ZoneID)
-	} else {
-		d.Set("hosted_zone_id", route53ZoneID)
-	}
+	d.Set("hosted_zone_id", HostedZoneIDForPartition(meta.(*conns.AWSClient).Partition))
 
 	tags, err := ListTags(ctx, conn, d.Get("arn").(string))
 	if err != nil {
@@ -1192,6 +1191,34 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 // resourceAwsCloudFrontWebDistributionWaitUntilDeployed blocks until the
 // distribution is deployed. It currently takes exactly 15 minutes to deploy
 // but that might change in the future.
+// resourceDistributionCustomizeDiff rejects origins that set both an origin
+// access control and an S3 origin access identity, which CloudFront refuses.
+func resourceDistributionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("origin") {
+		return nil
+	}
+
+	for _, tfMapRaw := range diff.Get("origin").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["origin_access_control_id"].(string); !ok || v == "" {
+			continue
+		}
+
+		if v, ok := tfMap["s3_origin_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["origin_access_identity"].(string); ok && v != "" {
+				return fmt.Errorf("origin (%s): origin_access_control_id and s3_origin_config.origin_access_identity cannot both be set", tfMap["origin_id"])
+			}
+		}
+	}
+
+	return nil
+}
+
 func DistributionWaitUntilDeployed(ctx context.Context, id string, meta interface{}) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"InProgress"},
@@ -13,6 +13,7 @@ import (
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -29,6 +30,16 @@ const route53ZoneID = "Z2FDTNDATAQYW2"
 // ref: https://docs.amazonaws.cn/en_us/aws/latest/userguide/route53.html
 const cnRoute53ZoneID = "Z3RFFRIM2A3IF5"
 
+// HostedZoneIDForPartition returns the Route 53 hosted zone ID for CloudFront
+// distributions in the given partition.
+func HostedZoneIDForPartition(partition string) string {
+	if partition == endpoints.AwsCnPartitionID {
+		return cnRoute53ZoneID
+	}
+
+	return route53ZoneID
+}
+
 // Assemble the *cloudfront.DistributionConfig variable. Calls out to various
 // expander functions to convert attributes and sub-attributes to the various
 // complex structures which are necessary to properly build the
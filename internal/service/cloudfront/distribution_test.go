@@ -458,6 +458,22 @@ func TestAccCloudFrontDistribution_Origin_emptyOriginID(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontDistribution_Origin_originAccessControlAndIdentity(t *testing.T) {
+	ctx := acctest.Context(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDistributionConfig_originAccessControlAndIdentity(),
+				ExpectError: regexp.MustCompile(`origin_access_control_id and s3_origin_config.origin_access_identity cannot both be set`),
+			},
+		},
+	})
+}
+
 func TestAccCloudFrontDistribution_Origin_connectionAttempts(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2540,6 +2556,53 @@ resource "aws_cloudfront_distribution" "Origin_EmptyOriginID" {
 `, testAccDistributionRetainConfig())
 }
 
+func testAccDistributionConfig_originAccessControlAndIdentity() string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "test" {
+  origin {
+    domain_name = "example.s3.amazonaws.com"
+    origin_id   = "myS3Origin"
+
+    origin_access_control_id = "E2QWRUHAPOMQZL"
+
+    s3_origin_config {
+      origin_access_identity = "origin-access-identity/cloudfront/E127EXAMPLE51Z"
+    }
+  }
+
+  enabled = true
+
+  default_cache_behavior {
+    allowed_methods  = ["GET", "HEAD"]
+    cached_methods   = ["GET", "HEAD"]
+    target_origin_id = "myS3Origin"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "none"
+      }
+    }
+
+    viewer_protocol_policy = "allow-all"
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+
+  %[1]s
+}
+`, testAccDistributionRetainConfig())
+}
+
 func testAccDistributionConfig_http11() string {
 	return fmt.Sprintf(`
 resource "aws_cloudfront_distribution" "http_1_1" {
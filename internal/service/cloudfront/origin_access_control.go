@@ -48,7 +48,7 @@ func ResourceOriginAccessControl() *schema.Resource {
 			"origin_access_control_origin_type": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validation.StringInSlice(cloudfront.OriginAccessControlOriginTypes_Values(), false),
+				ValidateFunc: validation.StringInSlice(OriginAccessControlOriginTypes_Values(), false),
 			},
 			"signing_behavior": {
 				Type:         schema.TypeString,
@@ -11,9 +11,10 @@ func TestAccCloudHSMV2_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"Cluster": {
-			"basic":      testAccCluster_basic,
-			"disappears": testAccCluster_disappears,
-			"tags":       testAccCluster_Tags,
+			"basic":                 testAccCluster_basic,
+			"backupRetentionPolicy": testAccCluster_backupRetentionPolicy,
+			"disappears":            testAccCluster_disappears,
+			"tags":                  testAccCluster_Tags,
 		},
 		"Hsm": {
 			"availabilityZone":   testAccHSM_AvailabilityZone,
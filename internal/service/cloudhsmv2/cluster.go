@@ -2,21 +2,37 @@ package cloudhsmv2
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	hsmTypeHSM1Medium  = "hsm1.medium"
+	hsmTypeHSM2MMedium = "hsm2m.medium"
+)
+
+func hsmType_Values() []string {
+	return []string{
+		hsmTypeHSM1Medium,
+		hsmTypeHSM2MMedium,
+	}
+}
+
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -34,6 +50,33 @@ func ResourceCluster() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"backup_policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"backup_retention_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      cloudhsmv2.BackupRetentionTypeDays,
+							ValidateFunc: validation.StringInSlice(cloudhsmv2.BackupRetentionType_Values(), false),
+						},
+						"value": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(7, 379),
+						},
+					},
+				},
+			},
+
 			"source_backup_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -44,7 +87,7 @@ func ResourceCluster() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"hsm1.medium"}, false),
+				ValidateFunc: validation.StringInSlice(hsmType_Values(), false),
 			},
 
 			"subnet_ids": {
@@ -108,7 +151,10 @@ func ResourceCluster() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffSourceBackupHSMType,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -127,6 +173,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.TagList = Tags(tags.IgnoreAWS())
 	}
 
+	if v, ok := d.GetOk("backup_retention_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.BackupRetentionPolicy = expandBackupRetentionPolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("source_backup_identifier"); ok {
 		input.SourceBackupId = aws.String(v.(string))
 	}
@@ -190,6 +240,14 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 	log.Printf("[INFO] Reading CloudHSMv2 Cluster Information: %s", d.Id())
 
+	d.Set("backup_policy", cluster.BackupPolicy)
+	if cluster.BackupRetentionPolicy != nil {
+		if err := d.Set("backup_retention_policy", []interface{}{flattenBackupRetentionPolicy(cluster.BackupRetentionPolicy)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting backup_retention_policy: %s", err)
+		}
+	} else {
+		d.Set("backup_retention_policy", nil)
+	}
 	d.Set("cluster_id", cluster.ClusterId)
 	d.Set("cluster_state", cluster.State)
 	d.Set("security_group_id", cluster.SecurityGroup)
@@ -226,6 +284,20 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudHSMV2Conn()
 
+	if d.HasChange("backup_retention_policy") {
+		if v, ok := d.GetOk("backup_retention_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := &cloudhsmv2.ModifyClusterInput{
+				BackupRetentionPolicy: expandBackupRetentionPolicy(v.([]interface{})[0].(map[string]interface{})),
+				ClusterId:             aws.String(d.Id()),
+			}
+
+			log.Printf("[DEBUG] Modifying CloudHSMv2 Cluster: %s", input)
+			if _, err := conn.ModifyClusterWithContext(ctx, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "modifying CloudHSMv2 Cluster (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
@@ -273,3 +345,86 @@ func readClusterCertificates(cluster *cloudhsmv2.Cluster) []map[string]interface
 	}
 	return []map[string]interface{}{}
 }
+
+// customizeDiffSourceBackupHSMType prevents restoring a backup of an hsm2m.medium
+// cluster into an hsm1.medium cluster, which CloudHSM rejects only after the
+// (long-running) cluster creation has started.
+func customizeDiffSourceBackupHSMType(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChanges("hsm_type", "source_backup_identifier") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("hsm_type") || !diff.NewValueKnown("source_backup_identifier") {
+		return nil
+	}
+
+	backupID := diff.Get("source_backup_identifier").(string)
+
+	if backupID == "" || diff.Get("hsm_type").(string) != hsmTypeHSM1Medium {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).CloudHSMV2Conn()
+
+	backup, err := FindBackupByID(ctx, conn, backupID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading CloudHSMv2 Backup (%s): %w", backupID, err)
+	}
+
+	clusterID := aws.StringValue(backup.ClusterId)
+	if clusterID == "" {
+		clusterID = aws.StringValue(backup.SourceCluster)
+	}
+
+	// The source cluster may since have been deleted, in which case its HSM type can't be checked.
+	cluster, err := FindCluster(ctx, conn, clusterID)
+
+	if err != nil || cluster == nil {
+		return nil
+	}
+
+	if v := aws.StringValue(cluster.HsmType); v == hsmTypeHSM2MMedium {
+		return fmt.Errorf("CloudHSMv2 Backup (%s) was taken from an %s cluster and cannot be restored to an %s cluster", backupID, v, hsmTypeHSM1Medium)
+	}
+
+	return nil
+}
+
+func expandBackupRetentionPolicy(tfMap map[string]interface{}) *cloudhsmv2.BackupRetentionPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudhsmv2.BackupRetentionPolicy{}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(int); ok && v != 0 {
+		apiObject.Value = aws.String(strconv.Itoa(v))
+	}
+
+	return apiObject
+}
+
+func flattenBackupRetentionPolicy(apiObject *cloudhsmv2.BackupRetentionPolicy) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"type": aws.StringValue(apiObject.Type),
+	}
+
+	if v, err := strconv.Atoi(aws.StringValue(apiObject.Value)); err == nil {
+		tfMap["value"] = v
+	}
+
+	return tfMap
+}
@@ -29,6 +29,10 @@ func testAccCluster_basic(t *testing.T) {
 				Config: testAccClusterConfig_basic(),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckClusterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup_policy", cloudhsmv2.BackupPolicyDefault),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.0.type", cloudhsmv2.BackupRetentionTypeDays),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.0.value", "90"),
 					resource.TestMatchResourceAttr(resourceName, "cluster_id", regexp.MustCompile(`^cluster-.+`)),
 					resource.TestCheckResourceAttr(resourceName, "cluster_state", cloudhsmv2.ClusterStateUninitialized),
 					resource.TestCheckResourceAttr(resourceName, "hsm_type", "hsm1.medium"),
@@ -121,6 +125,43 @@ func testAccCluster_Tags(t *testing.T) {
 	})
 }
 
+func testAccCluster_backupRetentionPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudhsm_v2_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudhsmv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_backupRetentionPolicy(7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.0.type", cloudhsmv2.BackupRetentionTypeDays),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.0.value", "7"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cluster_certificates"},
+			},
+			{
+				Config: testAccClusterConfig_backupRetentionPolicy(379),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_policy.0.value", "379"),
+				),
+			},
+		},
+	})
+}
+
 func testAccClusterBaseConfig() string {
 	return `
 data "aws_availability_zones" "available" {
@@ -155,6 +196,19 @@ resource "aws_cloudhsm_v2_cluster" "test" {
 `)
 }
 
+func testAccClusterConfig_backupRetentionPolicy(days int) string {
+	return acctest.ConfigCompose(testAccClusterBaseConfig(), fmt.Sprintf(`
+resource "aws_cloudhsm_v2_cluster" "test" {
+  hsm_type   = "hsm1.medium"
+  subnet_ids = aws_subnet.test[*].id
+
+  backup_retention_policy {
+    value = %[1]d
+  }
+}
+`, days))
+}
+
 func testAccClusterConfig_tags1(tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccClusterBaseConfig(), fmt.Sprintf(`
 resource "aws_cloudhsm_v2_cluster" "test" {
@@ -5,3 +5,24 @@ const (
 	ResNamePoolProviderPrincipalTag = "Pool Provider Principal Tag"
 	ResNamePool                     = "Pool"
 )
+
+// Domains of the public login providers supported by Cognito Identity Pools.
+const (
+	loginProviderAmazon   = "www.amazon.com"
+	loginProviderApple    = "appleid.apple.com"
+	loginProviderDigits   = "www.digits.com"
+	loginProviderFacebook = "graph.facebook.com"
+	loginProviderGoogle   = "accounts.google.com"
+	loginProviderTwitter  = "api.twitter.com"
+)
+
+func loginProvider_Values() []string {
+	return []string{
+		loginProviderAmazon,
+		loginProviderApple,
+		loginProviderDigits,
+		loginProviderFacebook,
+		loginProviderGoogle,
+		loginProviderTwitter,
+	}
+}
@@ -21,7 +21,7 @@ func expandIdentityPoolRoleMappingsAttachment(rms []interface{}) map[string]*cog
 			Type: aws.String(rm["type"].(string)),
 		}
 
-		if sv, ok := rm["ambiguous_role_resolution"].(string); ok {
+		if sv, ok := rm["ambiguous_role_resolution"].(string); ok && sv != "" {
 			roleMapping.AmbiguousRoleResolution = aws.String(sv)
 		}
 
@@ -103,12 +103,13 @@ func flattenIdentityPoolRoleMappingsAttachment(rms map[string]*cognitoidentity.R
 	}
 
 	for k, v := range rms {
-		m := make(map[string]interface{})
-
+		// Skip an empty mapping rather than dropping every other mapping.
 		if v == nil {
-			return nil
+			continue
 		}
 
+		m := make(map[string]interface{})
+
 		if v.Type != nil {
 			m["type"] = aws.StringValue(v.Type)
 		}
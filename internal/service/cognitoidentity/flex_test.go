@@ -0,0 +1,44 @@
+package cognitoidentity
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+)
+
+func TestFlattenIdentityPoolRoleMappingsAttachment(t *testing.T) {
+	t.Parallel()
+
+	rms := map[string]*cognitoidentity.RoleMapping{
+		"graph.facebook.com": nil,
+		"accounts.google.com": {
+			Type:                    aws.String(cognitoidentity.RoleMappingTypeRules),
+			AmbiguousRoleResolution: aws.String(cognitoidentity.AmbiguousRoleResolutionTypeDeny),
+			RulesConfiguration: &cognitoidentity.RulesConfigurationType{
+				Rules: []*cognitoidentity.MappingRule{
+					{
+						Claim:     aws.String("isAdmin"),
+						MatchType: aws.String(cognitoidentity.MappingRuleMatchTypeEquals),
+						RoleARN:   aws.String("arn:aws:iam::123456789012:role/admin"), // lintignore:AWSAT005
+						Value:     aws.String("paid"),
+					},
+				},
+			},
+		},
+	}
+
+	got := flattenIdentityPoolRoleMappingsAttachment(rms)
+
+	if len(got) != 1 {
+		t.Fatalf("expected 1 role mapping, got %d: %#v", len(got), got)
+	}
+
+	if v := got[0]["identity_provider"]; v != "accounts.google.com" {
+		t.Errorf("expected identity_provider %q, got %q", "accounts.google.com", v)
+	}
+
+	if v, ok := got[0]["mapping_rule"].([]interface{}); !ok || len(v) != 1 {
+		t.Errorf("expected 1 mapping rule, got %#v", got[0]["mapping_rule"])
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -67,6 +68,18 @@ func ResourcePool() *schema.Resource {
 				},
 			},
 
+			"merge_identity_providers": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"skip_login_providers_validation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"developer_provider_name": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -117,7 +130,10 @@ func ResourcePool() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourcePoolCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -180,7 +196,7 @@ func resourcePoolRead(ctx context.Context, d *schema.ResourceData, meta interfac
 		IdentityPoolId: aws.String(d.Id()),
 	})
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, cognitoidentity.ErrCodeResourceNotFoundException) {
-		create.LogNotFoundRemoveState(names.CognitoIdentity, create.ErrActionReading, ResNamePool, d.Id())
+		diags = create.AppendWarningNotFoundRemoveState(diags, names.CognitoIdentity, create.ErrActionReading, ResNamePool, d.Id(), d.Get("arn").(string), err)
 		d.SetId("")
 		return diags
 	}
@@ -201,6 +217,12 @@ func resourcePoolRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set("allow_unauthenticated_identities", ip.AllowUnauthenticatedIdentities)
 	d.Set("allow_classic_flow", ip.AllowClassicFlow)
 	d.Set("developer_provider_name", ip.DeveloperProviderName)
+	if v, ok := d.GetOk("merge_identity_providers"); ok {
+		d.Set("merge_identity_providers", v.(bool))
+	} else {
+		d.Set("merge_identity_providers", false)
+	}
+	d.Set("skip_login_providers_validation", d.Get("skip_login_providers_validation").(bool))
 	tags := KeyValueTags(ip.IdentityPoolTags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
@@ -212,7 +234,13 @@ func resourcePoolRead(ctx context.Context, d *schema.ResourceData, meta interfac
 		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
 	}
 
-	if err := d.Set("cognito_identity_providers", flattenIdentityProviders(ip.CognitoIdentityProviders)); err != nil {
+	identityProviders := ip.CognitoIdentityProviders
+	if d.Get("merge_identity_providers").(bool) {
+		// Only track the identity providers managed by this resource.
+		identityProviders = filterIdentityProviders(identityProviders, d.Get("cognito_identity_providers").(*schema.Set))
+	}
+
+	if err := d.Set("cognito_identity_providers", flattenIdentityProviders(identityProviders)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "Error setting cognito_identity_providers error: %s", err)
 	}
 
@@ -237,12 +265,33 @@ func resourcePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	log.Print("[DEBUG] Updating Cognito Identity Pool")
 
 	if d.HasChangesExcept("tags_all", "tags") {
+		identityProviders := expandIdentityProviders(d.Get("cognito_identity_providers").(*schema.Set))
+
+		if d.Get("merge_identity_providers").(bool) {
+			ip, err := conn.DescribeIdentityPoolWithContext(ctx, &cognitoidentity.DescribeIdentityPoolInput{
+				IdentityPoolId: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading Cognito Identity Pool (%s): %s", d.Id(), err)
+			}
+
+			// Keep the identity providers that were added outside of this resource.
+			o, n := d.GetChange("cognito_identity_providers")
+			managed := o.(*schema.Set).Union(n.(*schema.Set))
+			for _, v := range ip.CognitoIdentityProviders {
+				if v != nil && !identityProvidersContain(managed, v) {
+					identityProviders = append(identityProviders, v)
+				}
+			}
+		}
+
 		params := &cognitoidentity.IdentityPool{
 			IdentityPoolId:                 aws.String(d.Id()),
 			AllowUnauthenticatedIdentities: aws.Bool(d.Get("allow_unauthenticated_identities").(bool)),
 			AllowClassicFlow:               aws.Bool(d.Get("allow_classic_flow").(bool)),
 			IdentityPoolName:               aws.String(d.Get("identity_pool_name").(string)),
-			CognitoIdentityProviders:       expandIdentityProviders(d.Get("cognito_identity_providers").(*schema.Set)),
+			CognitoIdentityProviders:       identityProviders,
 			SupportedLoginProviders:        expandSupportedLoginProviders(d.Get("supported_login_providers").(map[string]interface{})),
 			OpenIdConnectProviderARNs:      flex.ExpandStringSet(d.Get("openid_connect_provider_arns").(*schema.Set)),
 			SamlProviderARNs:               flex.ExpandStringList(d.Get("saml_provider_arns").([]interface{})),
@@ -280,3 +329,41 @@ func resourcePoolDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 	return diags
 }
+
+// identityProvidersContain returns whether the set of identity providers contains
+// a provider with the same provider name and client ID.
+func identityProvidersContain(s *schema.Set, ip *cognitoidentity.Provider) bool {
+	for _, v := range s.List() {
+		tfMap := v.(map[string]interface{})
+
+		if tfMap["provider_name"].(string) == aws.StringValue(ip.ProviderName) && tfMap["client_id"].(string) == aws.StringValue(ip.ClientId) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func filterIdentityProviders(ips []*cognitoidentity.Provider, s *schema.Set) []*cognitoidentity.Provider {
+	var result []*cognitoidentity.Provider
+
+	for _, v := range ips {
+		if v != nil && identityProvidersContain(s, v) {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+func resourcePoolCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("skip_login_providers_validation").(bool) || !diff.NewValueKnown("supported_login_providers") {
+		return nil
+	}
+
+	if errs := validSupportedLoginProvidersKeys(diff.Get("supported_login_providers").(map[string]interface{})); len(errs) > 0 {
+		return fmt.Errorf("validating supported_login_providers: %v", errs)
+	}
+
+	return nil
+}
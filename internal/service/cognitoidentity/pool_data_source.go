@@ -0,0 +1,159 @@
+package cognitoidentity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourcePool() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePoolRead,
+
+		Schema: map[string]*schema.Schema{
+			"allow_classic_flow": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"allow_unauthenticated_identities": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cognito_identity_providers": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provider_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"server_side_token_check": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"developer_provider_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_pool_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validIdentityPoolName,
+			},
+			"openid_connect_provider_arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"saml_provider_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"supported_login_providers": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourcePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIdentityConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name := d.Get("identity_pool_name").(string)
+	ip, err := findPoolByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Cognito Identity Pool (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(ip.IdentityPoolId))
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Region:    meta.(*conns.AWSClient).Region,
+		Service:   "cognito-identity",
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("identitypool/%s", d.Id()),
+	}
+	d.Set("arn", arn.String())
+	d.Set("allow_classic_flow", ip.AllowClassicFlow)
+	d.Set("allow_unauthenticated_identities", ip.AllowUnauthenticatedIdentities)
+	if err := d.Set("cognito_identity_providers", flattenIdentityProviders(ip.CognitoIdentityProviders)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting cognito_identity_providers: %s", err)
+	}
+	d.Set("developer_provider_name", ip.DeveloperProviderName)
+	d.Set("identity_pool_name", ip.IdentityPoolName)
+	d.Set("openid_connect_provider_arns", flex.FlattenStringList(ip.OpenIdConnectProviderARNs))
+	d.Set("saml_provider_arns", flex.FlattenStringList(ip.SamlProviderARNs))
+	d.Set("supported_login_providers", aws.StringValueMap(ip.SupportedLoginProviders))
+
+	if err := d.Set("tags", KeyValueTags(ip.IdentityPoolTags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
+
+func findPoolByName(ctx context.Context, conn *cognitoidentity.CognitoIdentity, name string) (*cognitoidentity.IdentityPool, error) {
+	input := &cognitoidentity.ListIdentityPoolsInput{
+		MaxResults: aws.Int64(60),
+	}
+	var ids []*string
+
+	err := conn.ListIdentityPoolsPagesWithContext(ctx, input, func(page *cognitoidentity.ListIdentityPoolsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.IdentityPools {
+			if v != nil && aws.StringValue(v.IdentityPoolName) == name {
+				ids = append(ids, v.IdentityPoolId)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(ids); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return conn.DescribeIdentityPoolWithContext(ctx, &cognitoidentity.DescribeIdentityPoolInput{
+		IdentityPoolId: ids[0],
+	})
+}
@@ -0,0 +1,64 @@
+package cognitoidentity_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCognitoIdentityPoolDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := sdkacctest.RandString(10)
+	dataSourceName := "data.aws_cognito_identity_pool.test"
+	resourceName := "aws_cognito_identity_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cognitoidentity.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolDataSourceConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					acctest.MatchResourceAttrRegionalARN(dataSourceName, "arn", "cognito-identity", regexp.MustCompile(`identitypool/.+`)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "identity_pool_name", resourceName, "identity_pool_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "allow_unauthenticated_identities", resourceName, "allow_unauthenticated_identities"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "developer_provider_name", resourceName, "developer_provider_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "supported_login_providers.%", resourceName, "supported_login_providers.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "supported_login_providers.graph.facebook.com", resourceName, "supported_login_providers.graph.facebook.com"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPoolDataSourceConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_identity_pool" "test" {
+  identity_pool_name               = "identity pool %[1]s"
+  allow_unauthenticated_identities = false
+  developer_provider_name          = "my.developer"
+
+  supported_login_providers = {
+    "graph.facebook.com" = "7346241598935555"
+  }
+
+  tags = {
+    key1 = "value1"
+  }
+}
+
+data "aws_cognito_identity_pool" "test" {
+  identity_pool_name = aws_cognito_identity_pool.test.identity_pool_name
+}
+`, name)
+}
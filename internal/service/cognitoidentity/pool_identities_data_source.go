@@ -0,0 +1,134 @@
+package cognitoidentity
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourcePoolIdentities() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePoolIdentitiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"hide_disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"identities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"creation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identity_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logins": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"identity_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourcePoolIdentitiesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIdentityConn()
+
+	identityPoolID := d.Get("identity_pool_id").(string)
+	input := &cognitoidentity.ListIdentitiesInput{
+		HideDisabled:   aws.Bool(d.Get("hide_disabled").(bool)),
+		IdentityPoolId: aws.String(identityPoolID),
+		MaxResults:     aws.Int64(60),
+	}
+
+	identities, err := findIdentities(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Cognito Identity Pool (%s) identities: %s", identityPoolID, err)
+	}
+
+	d.SetId(identityPoolID)
+	if err := d.Set("identities", flattenIdentityDescriptions(identities)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting identities: %s", err)
+	}
+
+	return diags
+}
+
+// findIdentities pages through ListIdentities, which has no paginator in the SDK.
+func findIdentities(ctx context.Context, conn *cognitoidentity.CognitoIdentity, input *cognitoidentity.ListIdentitiesInput) ([]*cognitoidentity.IdentityDescription, error) {
+	var output []*cognitoidentity.IdentityDescription
+
+	for {
+		page, err := conn.ListIdentitiesWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		for _, v := range page.Identities {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func flattenIdentityDescriptions(apiObjects []*cognitoidentity.IdentityDescription) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"identity_id": aws.StringValue(apiObject.IdentityId),
+			"logins":      aws.StringValueSlice(apiObject.Logins),
+		}
+
+		if v := apiObject.CreationDate; v != nil {
+			tfMap["creation_date"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.LastModifiedDate; v != nil {
+			tfMap["last_modified_date"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
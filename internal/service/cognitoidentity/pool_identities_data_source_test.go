@@ -0,0 +1,81 @@
+package cognitoidentity_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccCognitoIdentityPoolIdentitiesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := sdkacctest.RandString(10)
+	dataSourceName := "data.aws_cognito_identity_pool_identities.test"
+	resourceName := "aws_cognito_identity_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cognitoidentity.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolIdentitiesDataSourceConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "identity_pool_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "identities.#", "0"),
+					testAccAddPoolIdentity(ctx, resourceName),
+				),
+			},
+			{
+				Config: testAccPoolIdentitiesDataSourceConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "identities.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "identities.0.identity_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "identities.0.creation_date"),
+					resource.TestCheckResourceAttr(dataSourceName, "identities.0.logins.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// testAccAddPoolIdentity creates an unauthenticated identity in the pool outside of Terraform.
+func testAccAddPoolIdentity(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIdentityConn()
+
+		_, err := conn.GetIdWithContext(ctx, &cognitoidentity.GetIdInput{
+			AccountId:      aws.String(acctest.AccountID()),
+			IdentityPoolId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccPoolIdentitiesDataSourceConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_identity_pool" "test" {
+  identity_pool_name               = "identity pool %[1]s"
+  allow_unauthenticated_identities = true
+}
+
+data "aws_cognito_identity_pool_identities" "test" {
+  identity_pool_id = aws_cognito_identity_pool.test.id
+}
+`, name)
+}
@@ -70,16 +70,13 @@ func resourcePoolProviderPrincipalTagCreate(ctx context.Context, d *schema.Resou
 	params := &cognitoidentity.SetPrincipalTagAttributeMapInput{
 		IdentityPoolId:       aws.String(poolId),
 		IdentityProviderName: aws.String(providerName),
+		UseDefaults:          aws.Bool(d.Get("use_defaults").(bool)),
 	}
 
 	if v, ok := d.GetOk("principal_tags"); ok {
 		params.PrincipalTags = flex.ExpandStringMap(v.(map[string]interface{}))
 	}
 
-	if v, ok := d.GetOk("use_defaults"); ok {
-		params.UseDefaults = aws.Bool(v.(bool))
-	}
-
 	_, err := conn.SetPrincipalTagAttributeMapWithContext(ctx, params)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Cognito Identity Provider Principal Tags: %s", err)
@@ -107,7 +104,7 @@ func resourcePoolProviderPrincipalTagRead(ctx context.Context, d *schema.Resourc
 	})
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, cognitoidentity.ErrCodeResourceNotFoundException) {
-		create.LogNotFoundRemoveState(names.CognitoIdentity, create.ErrActionReading, ResNamePoolProviderPrincipalTag, d.Id())
+		diags = create.AppendWarningNotFoundRemoveState(diags, names.CognitoIdentity, create.ErrActionReading, ResNamePoolProviderPrincipalTag, d.Id(), "", err)
 		d.SetId("")
 		return diags
 	}
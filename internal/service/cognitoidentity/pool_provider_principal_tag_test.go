@@ -34,6 +34,7 @@ func TestAccCognitoIdentityPoolProviderPrincipalTags_basic(t *testing.T) {
 					testAccCheckPoolProviderPrincipalTagsExists(ctx, resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "identity_pool_id"),
 					resource.TestCheckResourceAttr(resourceName, "principal_tags.test", "value"),
+					resource.TestCheckResourceAttr(resourceName, "use_defaults", "false"),
 				),
 			},
 		},
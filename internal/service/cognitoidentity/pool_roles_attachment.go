@@ -28,6 +28,8 @@ func ResourcePoolRolesAttachment() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourcePoolRolesAttachmentCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"identity_pool_id": {
 				Type:     schema.TypeString,
@@ -115,24 +117,12 @@ func resourcePoolRolesAttachmentCreate(ctx context.Context, d *schema.ResourceDa
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CognitoIdentityConn()
 
-	// Validates role keys to be either authenticated or unauthenticated,
-	// since ValidateFunc validates only the value not the key.
-	if errors := validRoles(d.Get("roles").(map[string]interface{})); len(errors) > 0 {
-		return sdkdiag.AppendErrorf(diags, "Error validating Roles: %v", errors)
-	}
-
 	params := &cognitoidentity.SetIdentityPoolRolesInput{
 		IdentityPoolId: aws.String(d.Get("identity_pool_id").(string)),
 		Roles:          expandIdentityPoolRoles(d.Get("roles").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("role_mapping"); ok {
-		errors := validateRoleMappings(v.(*schema.Set).List())
-
-		if len(errors) > 0 {
-			return sdkdiag.AppendErrorf(diags, "Error validating ambiguous role resolution: %v", errors)
-		}
-
 		params.RoleMappings = expandIdentityPoolRoleMappingsAttachment(v.(*schema.Set).List())
 	}
 
@@ -156,7 +146,7 @@ func resourcePoolRolesAttachmentRead(ctx context.Context, d *schema.ResourceData
 		IdentityPoolId: aws.String(d.Id()),
 	})
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, cognitoidentity.ErrCodeResourceNotFoundException) {
-		create.LogNotFoundRemoveState(names.CognitoIdentity, create.ErrActionReading, ResNamePoolRolesAttachment, d.Id())
+		diags = create.AppendWarningNotFoundRemoveState(diags, names.CognitoIdentity, create.ErrActionReading, ResNamePoolRolesAttachment, d.Id(), "", err)
 		d.SetId("")
 		return diags
 	}
@@ -182,12 +172,6 @@ func resourcePoolRolesAttachmentUpdate(ctx context.Context, d *schema.ResourceDa
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CognitoIdentityConn()
 
-	// Validates role keys to be either authenticated or unauthenticated,
-	// since ValidateFunc validates only the value not the key.
-	if errors := validRoles(d.Get("roles").(map[string]interface{})); len(errors) > 0 {
-		return sdkdiag.AppendErrorf(diags, "Error validating Roles: %v", errors)
-	}
-
 	params := &cognitoidentity.SetIdentityPoolRolesInput{
 		IdentityPoolId: aws.String(d.Get("identity_pool_id").(string)),
 		Roles:          expandIdentityPoolRoles(d.Get("roles").(map[string]interface{})),
@@ -198,11 +182,6 @@ func resourcePoolRolesAttachmentUpdate(ctx context.Context, d *schema.ResourceDa
 		var mappings []interface{}
 
 		if ok {
-			errors := validateRoleMappings(v.(*schema.Set).List())
-
-			if len(errors) > 0 {
-				return sdkdiag.AppendErrorf(diags, "Error validating ambiguous role resolution: %v", errors)
-			}
 			mappings = v.(*schema.Set).List()
 		} else {
 			mappings = []interface{}{}
@@ -240,10 +219,38 @@ func resourcePoolRolesAttachmentDelete(ctx context.Context, d *schema.ResourceDa
 	return diags
 }
 
+// resourcePoolRolesAttachmentCustomizeDiff validates roles and role mappings at
+// plan time, as the constraints span several attributes and map keys.
+func resourcePoolRolesAttachmentCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Validates role keys to be either authenticated or unauthenticated,
+	// since ValidateFunc validates only the value not the key.
+	if diff.NewValueKnown("roles") {
+		if errors := validRoles(diff.Get("roles").(map[string]interface{})); len(errors) > 0 {
+			return fmt.Errorf("Error validating Roles: %v", errors)
+		}
+	}
+
+	// Unknown identity providers all share the same placeholder value in the diff,
+	// so uniqueness is checked against the raw configuration, where they can be told apart.
+	if v := diff.GetRawConfig(); v.IsKnown() && !v.IsNull() {
+		if errors := validRoleMappingsIdentityProviders(knownRoleMappingsIdentityProviders(v.GetAttr("role_mapping"))); len(errors) > 0 {
+			return fmt.Errorf("Error validating role mappings: %v", errors)
+		}
+	}
+
+	if diff.NewValueKnown("role_mapping") {
+		if errors := validateRoleMappings(diff.Get("role_mapping").(*schema.Set).List()); len(errors) > 0 {
+			return fmt.Errorf("Error validating ambiguous role resolution: %v", errors)
+		}
+	}
+
+	return nil
+}
+
 // Validating that each role_mapping ambiguous_role_resolution
 // is defined when "type" equals Token or Rules.
 func validateRoleMappings(roleMappings []interface{}) []error {
-	errors := make([]error, 0)
+	var errors []error
 
 	for _, r := range roleMappings {
 		rm := r.(map[string]interface{})
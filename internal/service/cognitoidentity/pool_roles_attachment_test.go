@@ -81,6 +81,15 @@ func TestAccCognitoIdentityPoolRolesAttachment_roleMappings(t *testing.T) {
 					testAccCheckPoolRolesAttachmentExists(ctx, resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "identity_pool_id"),
 					resource.TestCheckResourceAttr(resourceName, "role_mapping.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "role_mapping.*", map[string]string{
+						"identity_provider":         "graph.facebook.com",
+						"ambiguous_role_resolution": "AuthenticatedRole",
+						"type":                      "Rules",
+						"mapping_rule.#":            "1",
+						"mapping_rule.0.claim":      "isAdmin",
+						"mapping_rule.0.match_type": "Equals",
+						"mapping_rule.0.value":      "paid",
+					}),
 					resource.TestCheckResourceAttrSet(resourceName, "roles.authenticated"),
 				),
 			},
@@ -95,9 +104,21 @@ func TestAccCognitoIdentityPoolRolesAttachment_roleMappings(t *testing.T) {
 					testAccCheckPoolRolesAttachmentExists(ctx, resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "identity_pool_id"),
 					resource.TestCheckResourceAttr(resourceName, "role_mapping.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "role_mapping.*", map[string]string{
+						"identity_provider":    "graph.facebook.com",
+						"type":                 "Rules",
+						"mapping_rule.#":       "2",
+						"mapping_rule.0.claim": "isPaid",
+						"mapping_rule.1.claim": "isFoo",
+					}),
 					resource.TestCheckResourceAttrSet(resourceName, "roles.authenticated"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccPoolRolesAttachmentConfig_basic(name),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -111,6 +132,48 @@ func TestAccCognitoIdentityPoolRolesAttachment_roleMappings(t *testing.T) {
 	})
 }
 
+func TestAccCognitoIdentityPoolRolesAttachment_roleMappingsToken(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cognito_identity_pool_roles_attachment.test"
+	name := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cognitoidentity.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoolRolesAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolRolesAttachmentConfig_roleMappingsToken(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolRolesAttachmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role_mapping.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "role_mapping.*", map[string]string{
+						"identity_provider":         "graph.facebook.com",
+						"ambiguous_role_resolution": "Deny",
+						"type":                      "Token",
+						"mapping_rule.#":            "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "role_mapping.*", map[string]string{
+						"identity_provider":         "accounts.google.com",
+						"ambiguous_role_resolution": "AuthenticatedRole",
+						"type":                      "Rules",
+						"mapping_rule.#":            "1",
+						"mapping_rule.0.claim":      "email",
+						"mapping_rule.0.match_type": "StartsWith",
+						"mapping_rule.0.value":      "admin",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccCognitoIdentityPoolRolesAttachment_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_cognito_identity_pool_roles_attachment.test"
@@ -241,7 +304,8 @@ resource "aws_cognito_identity_pool" "main" {
   allow_unauthenticated_identities = false
 
   supported_login_providers = {
-    "graph.facebook.com" = "7346241598935555"
+    "accounts.google.com" = "123456789012.apps.googleusercontent.com"
+    "graph.facebook.com"  = "7346241598935555"
   }
 }
 
@@ -419,6 +483,37 @@ resource "aws_cognito_identity_pool_roles_attachment" "test" {
 `)
 }
 
+func testAccPoolRolesAttachmentConfig_roleMappingsToken(name string) string {
+	return fmt.Sprintf(testAccPoolRolesAttachmentConfig(name) + `
+resource "aws_cognito_identity_pool_roles_attachment" "test" {
+  identity_pool_id = aws_cognito_identity_pool.main.id
+
+  role_mapping {
+    identity_provider         = "graph.facebook.com"
+    ambiguous_role_resolution = "Deny"
+    type                      = "Token"
+  }
+
+  role_mapping {
+    identity_provider         = "accounts.google.com"
+    ambiguous_role_resolution = "AuthenticatedRole"
+    type                      = "Rules"
+
+    mapping_rule {
+      claim      = "email"
+      match_type = "StartsWith"
+      role_arn   = aws_iam_role.authenticated.arn
+      value      = "admin"
+    }
+  }
+
+  roles = {
+    "authenticated" = aws_iam_role.authenticated.arn
+  }
+}
+`)
+}
+
 func testAccPoolRolesAttachmentConfig_roleMappingsWithAmbiguousRoleResolutionError(name string) string {
 	return fmt.Sprintf(testAccPoolRolesAttachmentConfig(name) + `
 resource "aws_cognito_identity_pool_roles_attachment" "test" {
@@ -148,6 +148,24 @@ func TestAccCognitoIdentityPool_supportedLoginProviders(t *testing.T) {
 	})
 }
 
+func TestAccCognitoIdentityPool_supportedLoginProvidersUnrecognized(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cognitoidentity.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPoolConfig_supportedLoginProvidersUnrecognized(name),
+				ExpectError: regexp.MustCompile(`supported login provider "graph.facebok.com" is not one of`),
+			},
+		},
+	})
+}
+
 func TestAccCognitoIdentityPool_openidConnectProviderARNs(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v1, v2, v3 cognitoidentity.IdentityPool
@@ -357,6 +375,43 @@ func TestAccCognitoIdentityPool_addingNewProviderKeepsOldProvider(t *testing.T)
 	})
 }
 
+func TestAccCognitoIdentityPool_mergeIdentityProviders(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 cognitoidentity.IdentityPool
+	name := sdkacctest.RandString(10)
+	resourceName := "aws_cognito_identity_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cognitoidentity.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolConfig_mergeIdentityProviders(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "merge_identity_providers", "true"),
+					resource.TestCheckResourceAttr(resourceName, "cognito_identity_providers.#", "1"),
+					testAccAddPoolIdentityProvider(ctx, &v1),
+				),
+			},
+			{
+				Config: testAccPoolConfig_mergeIdentityProviders(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolExists(ctx, resourceName, &v2),
+					testAccCheckPoolNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "cognito_identity_providers.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cognito_identity_providers.*", map[string]string{
+						"server_side_token_check": "true",
+					}),
+					testAccCheckPoolIdentityProviderCount(&v2, 2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCognitoIdentityPool_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v1, v2, v3 cognitoidentity.IdentityPool
@@ -459,6 +514,39 @@ func testAccCheckPoolExists(ctx context.Context, n string, identityPool *cognito
 	}
 }
 
+// testAccAddPoolIdentityProvider adds an identity provider to the pool outside of Terraform.
+func testAccAddPoolIdentityProvider(ctx context.Context, identityPool *cognitoidentity.IdentityPool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIdentityConn()
+
+		input := &cognitoidentity.IdentityPool{
+			AllowClassicFlow:               identityPool.AllowClassicFlow,
+			AllowUnauthenticatedIdentities: identityPool.AllowUnauthenticatedIdentities,
+			CognitoIdentityProviders: append(identityPool.CognitoIdentityProviders, &cognitoidentity.Provider{
+				ClientId:             aws.String("6lhlkkfbfb4q5kpp90urffae"),
+				ProviderName:         aws.String(fmt.Sprintf("cognito-idp.%[1]s.%[2]s/%[1]s_Zr231apJu", acctest.Region(), acctest.PartitionDNSSuffix())),
+				ServerSideTokenCheck: aws.Bool(false),
+			}),
+			IdentityPoolId:   identityPool.IdentityPoolId,
+			IdentityPoolName: identityPool.IdentityPoolName,
+		}
+
+		_, err := conn.UpdateIdentityPoolWithContext(ctx, input)
+
+		return err
+	}
+}
+
+func testAccCheckPoolIdentityProviderCount(identityPool *cognitoidentity.IdentityPool, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if actual := len(identityPool.CognitoIdentityProviders); actual != expected {
+			return fmt.Errorf("Cognito Identity Pool (%s) has %d identity providers, expected %d", aws.StringValue(identityPool.IdentityPoolId), actual, expected)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckPoolDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIdentityConn()
@@ -575,6 +663,19 @@ resource "aws_cognito_identity_pool" "test" {
 `, name)
 }
 
+func testAccPoolConfig_supportedLoginProvidersUnrecognized(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_identity_pool" "test" {
+  identity_pool_name               = "identity pool %s"
+  allow_unauthenticated_identities = false
+
+  supported_login_providers = {
+    "graph.facebok.com" = "7346241598935555"
+  }
+}
+`, name)
+}
+
 func testAccPoolConfig_openidConnectProviderARNs(name string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -682,6 +783,26 @@ resource "aws_cognito_identity_pool" "test" {
 `, name)
 }
 
+func testAccPoolConfig_mergeIdentityProviders(name string, serverSideTokenCheck bool) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_cognito_identity_pool" "test" {
+  identity_pool_name               = "identity pool %[1]s"
+  allow_unauthenticated_identities = false
+  merge_identity_providers         = true
+
+  cognito_identity_providers {
+    client_id               = "7lhlkkfbfb4q5kpp90urffao"
+    provider_name           = "cognito-idp.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}/${data.aws_region.current.name}_Ab129faBb"
+    server_side_token_check = %[2]t
+  }
+}
+`, name, serverSideTokenCheck)
+}
+
 func testAccPoolConfig_identityProvidersAndOpenIDConnectProviderARNs(name string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
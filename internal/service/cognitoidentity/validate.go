@@ -3,8 +3,10 @@ package cognitoidentity
 import (
 	"fmt"
 	"regexp"
+	"sort"
 
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/hashicorp/go-cty/cty"
 )
 
 func validIdentityPoolName(v interface{}, k string) (ws []string, errors []error) {
@@ -102,6 +104,50 @@ func validRoleMappingsRulesConfiguration(v map[string]interface{}) (errors []err
 	return
 }
 
+// Validates that each identity provider has at most one role mapping,
+// as role mappings are keyed by identity provider.
+func validRoleMappingsIdentityProviders(identityProviders []string) (errors []error) {
+	seen := make(map[string]bool)
+
+	for _, identityProvider := range identityProviders {
+		if seen[identityProvider] {
+			errors = append(errors, fmt.Errorf("Role Mapping %q: identity_provider must be unique", identityProvider))
+		}
+
+		seen[identityProvider] = true
+	}
+
+	return
+}
+
+// knownRoleMappingsIdentityProviders returns the identity_provider of each role_mapping
+// in the raw configuration value, skipping those that are not yet known.
+func knownRoleMappingsIdentityProviders(roleMappings cty.Value) []string {
+	var identityProviders []string
+
+	if !roleMappings.IsKnown() || roleMappings.IsNull() {
+		return identityProviders
+	}
+
+	for it := roleMappings.ElementIterator(); it.Next(); {
+		_, v := it.Element()
+
+		if !v.IsKnown() || v.IsNull() {
+			continue
+		}
+
+		identityProvider := v.GetAttr("identity_provider")
+
+		if !identityProvider.IsKnown() || identityProvider.IsNull() {
+			continue
+		}
+
+		identityProviders = append(identityProviders, identityProvider.AsString())
+	}
+
+	return identityProviders
+}
+
 // Validates that either authenticated or unauthenticated is defined
 func validRoles(v map[string]interface{}) (errors []error) {
 	k := "roles"
@@ -115,6 +161,31 @@ func validRoles(v map[string]interface{}) (errors []error) {
 	return
 }
 
+// validSupportedLoginProvidersKeys validates that each supported login provider is a recognized provider domain.
+func validSupportedLoginProvidersKeys(v map[string]interface{}) (errors []error) {
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		found := false
+		for _, provider := range loginProvider_Values() {
+			if k == provider {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			errors = append(errors, fmt.Errorf("supported login provider %q is not one of %q, set skip_login_providers_validation to use it anyway", k, loginProvider_Values()))
+		}
+	}
+
+	return
+}
+
 func validSupportedLoginProviders(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if len(value) < 1 {
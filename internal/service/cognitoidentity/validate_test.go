@@ -1,10 +1,13 @@
 package cognitoidentity
 
 import (
+	"reflect"
+	"sort"
 	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/hashicorp/go-cty/cty"
 )
 
 func TestValidIdentityPoolName(t *testing.T) {
@@ -250,6 +253,129 @@ func TestValidRoleMappingsRulesConfiguration(t *testing.T) {
 	}
 }
 
+func TestValidRoleMappingsIdentityProviders(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		IdentityProviders []string
+		ErrCount          int
+	}{
+		{
+			IdentityProviders: nil,
+			ErrCount:          0,
+		},
+		{
+			IdentityProviders: []string{"graph.facebook.com", "accounts.google.com"},
+			ErrCount:          0,
+		},
+		{
+			IdentityProviders: []string{"graph.facebook.com", "graph.facebook.com"},
+			ErrCount:          1,
+		},
+	}
+
+	for _, tc := range cases {
+		var roleMappings []cty.Value
+		for _, v := range tc.IdentityProviders {
+			roleMappings = append(roleMappings, testRoleMappingValue(cty.StringVal(v)))
+		}
+
+		errors := validRoleMappingsIdentityProviders(knownRoleMappingsIdentityProviders(testRoleMappingsValue(roleMappings)))
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Cognito Role Mappings validation failed: %v, expected err count %d, got %d, for config %#v", errors, tc.ErrCount, len(errors), roleMappings)
+		}
+	}
+}
+
+func TestKnownRoleMappingsIdentityProviders(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		RoleMappings cty.Value
+		Expected     []string
+		ErrCount     int
+	}{
+		{
+			RoleMappings: cty.NullVal(testRoleMappingsType()),
+			Expected:     nil,
+			ErrCount:     0,
+		},
+		{
+			RoleMappings: cty.UnknownVal(testRoleMappingsType()),
+			Expected:     nil,
+			ErrCount:     0,
+		},
+		{
+			// Identity providers computed from other resources are not known at plan time.
+			RoleMappings: testRoleMappingsValue([]cty.Value{
+				testRoleMappingValue(cty.UnknownVal(cty.String)),
+				testRoleMappingValue(cty.UnknownVal(cty.String)),
+			}),
+			Expected: nil,
+			ErrCount: 0,
+		},
+		{
+			RoleMappings: testRoleMappingsValue([]cty.Value{
+				testRoleMappingValue(cty.UnknownVal(cty.String)),
+				testRoleMappingValue(cty.StringVal("graph.facebook.com")),
+			}),
+			Expected: []string{"graph.facebook.com"},
+			ErrCount: 0,
+		},
+		{
+			RoleMappings: testRoleMappingsValue([]cty.Value{
+				testRoleMappingValue(cty.UnknownVal(cty.String)),
+				testRoleMappingValue(cty.StringVal("graph.facebook.com")),
+				cty.ObjectVal(map[string]cty.Value{
+					"ambiguous_role_resolution": cty.StringVal(cognitoidentity.AmbiguousRoleResolutionTypeDeny),
+					"identity_provider":         cty.StringVal("graph.facebook.com"),
+					"type":                      cty.StringVal(cognitoidentity.RoleMappingTypeToken),
+				}),
+			}),
+			Expected: []string{"graph.facebook.com", "graph.facebook.com"},
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		identityProviders := knownRoleMappingsIdentityProviders(tc.RoleMappings)
+		sort.Strings(identityProviders)
+
+		if !reflect.DeepEqual(identityProviders, tc.Expected) {
+			t.Fatalf("expected identity providers %v, got %v", tc.Expected, identityProviders)
+		}
+
+		errors := validRoleMappingsIdentityProviders(identityProviders)
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Cognito Role Mappings validation failed: %v, expected err count %d, got %d", errors, tc.ErrCount, len(errors))
+		}
+	}
+}
+
+func testRoleMappingsType() cty.Type {
+	return cty.Set(cty.Object(map[string]cty.Type{
+		"ambiguous_role_resolution": cty.String,
+		"identity_provider":         cty.String,
+		"type":                      cty.String,
+	}))
+}
+
+func testRoleMappingsValue(roleMappings []cty.Value) cty.Value {
+	if len(roleMappings) == 0 {
+		return cty.SetValEmpty(testRoleMappingsType().ElementType())
+	}
+
+	return cty.SetVal(roleMappings)
+}
+
+func testRoleMappingValue(identityProvider cty.Value) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"ambiguous_role_resolution": cty.NullVal(cty.String),
+		"identity_provider":         identityProvider,
+		"type":                      cty.StringVal(cognitoidentity.RoleMappingTypeToken),
+	})
+}
+
 func TestValidRoles(t *testing.T) {
 	t.Parallel()
 
@@ -279,6 +405,47 @@ func TestValidRoles(t *testing.T) {
 	}
 }
 
+func TestValidSupportedLoginProvidersKeys(t *testing.T) {
+	t.Parallel()
+
+	validValues := []map[string]interface{}{
+		{},
+		{
+			"graph.facebook.com": "7346241598935552",
+		},
+		{
+			"accounts.google.com": "123456789012.apps.googleusercontent.com", // nosemgrep:ci.domain-names
+			"api.twitter.com":     "xvz1evFS4wEEPTGEFPHBog;kAcSOqF21Fu85e7zjz7ZN2U4ZRhfV3WpwPAoE3Z7kBw",
+			"appleid.apple.com":   "com.example.app",
+			"www.amazon.com":      "amzn1.application.1234567890",
+		},
+	}
+
+	for _, v := range validValues {
+		errors := validSupportedLoginProvidersKeys(v)
+		if len(errors) > 0 {
+			t.Fatalf("%q should be valid Cognito Supported Login Providers: %v", v, errors)
+		}
+	}
+
+	invalidValues := []map[string]interface{}{
+		{
+			"graph.facebok.com": "7346241598935552",
+		},
+		{
+			"accounts.google.com": "123456789012.apps.googleusercontent.com", // nosemgrep:ci.domain-names
+			"www.example.com":     "foo",
+		},
+	}
+
+	for _, v := range invalidValues {
+		errors := validSupportedLoginProvidersKeys(v)
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be valid Cognito Supported Login Providers", v)
+		}
+	}
+}
+
 func TestValidSupportedLoginProviders(t *testing.T) {
 	t.Parallel()
 
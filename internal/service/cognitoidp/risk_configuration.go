@@ -42,9 +42,10 @@ func ResourceRiskConfiguration() *schema.Resource {
 				ForceNew: true,
 			},
 			"account_takeover_risk_configuration": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"account_takeover_risk_configuration", "compromised_credentials_risk_configuration", "risk_exception_configuration"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"actions": {
@@ -112,7 +113,7 @@ func ResourceRiskConfiguration() *schema.Resource {
 						},
 						"notify_configuration": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -208,9 +209,10 @@ func ResourceRiskConfiguration() *schema.Resource {
 				},
 			},
 			"compromised_credentials_risk_configuration": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"account_takeover_risk_configuration", "compromised_credentials_risk_configuration", "risk_exception_configuration"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"event_filter": {
@@ -240,9 +242,10 @@ func ResourceRiskConfiguration() *schema.Resource {
 				},
 			},
 			"risk_exception_configuration": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"account_takeover_risk_configuration", "compromised_credentials_risk_configuration", "risk_exception_configuration"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"blocked_ip_range_list": {
@@ -337,10 +340,8 @@ func resourceRiskConfigurationRead(ctx context.Context, d *schema.ResourceData,
 		d.Set("client_id", clientId)
 	}
 
-	if riskConfig.RiskExceptionConfiguration != nil {
-		if err := d.Set("risk_exception_configuration", flattenRiskExceptionConfiguration(riskConfig.RiskExceptionConfiguration)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting risk_exception_configuration: %s", err)
-		}
+	if err := d.Set("risk_exception_configuration", flattenRiskExceptionConfiguration(riskConfig.RiskExceptionConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting risk_exception_configuration: %s", err)
 	}
 
 	if err := d.Set("compromised_credentials_risk_configuration", flattenCompromisedCredentialsRiskConfiguration(riskConfig.CompromisedCredentialsRiskConfiguration)); err != nil {
@@ -696,7 +697,7 @@ func RiskConfigurationParseID(id string) (string, string, error) {
 	parts := strings.Split(id, ":")
 
 	if len(parts) > 2 || len(parts) < 1 {
-		return "", "", fmt.Errorf("wrong format of import ID (%s), use: 'userpool-id/client-id' or 'userpool-id'", id)
+		return "", "", fmt.Errorf("wrong format of import ID (%s), use: 'userpool-id:client-id' or 'userpool-id'", id)
 	}
 
 	if len(parts) == 2 {
@@ -30,6 +30,11 @@ func statusUserPoolDomain(ctx context.Context, conn *cognitoidentityprovider.Cog
 			return nil, userPoolDomainStatusNotFound, nil
 		}
 
+		// A domain that doesn't exist is described without a status.
+		if output.DomainDescription == nil || output.DomainDescription.Status == nil {
+			return nil, userPoolDomainStatusNotFound, nil
+		}
+
 		return output, aws.StringValue(output.DomainDescription.Status), nil
 	}
 }
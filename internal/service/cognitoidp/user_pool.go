@@ -222,6 +222,11 @@ func ResourceUserPool() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"lambda_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -892,6 +897,12 @@ func resourceUserPoolRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("sms_authentication_message", userPool.SmsAuthenticationMessage)
 	d.Set("deletion_protection", userPool.DeletionProtection)
 
+	if v, ok := d.GetOk("force_destroy"); ok {
+		d.Set("force_destroy", v.(bool))
+	} else {
+		d.Set("force_destroy", false)
+	}
+
 	if err := d.Set("device_configuration", flattenUserPoolDeviceConfiguration(userPool.DeviceConfiguration)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting device_configuration: %s", err)
 	}
@@ -1062,213 +1073,287 @@ func resourceUserPoolUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		"account_recovery_setting",
 		"deletion_protection",
 	) {
-		params := &cognitoidentityprovider.UpdateUserPoolInput{
-			UserPoolId: aws.String(d.Id()),
-		}
+		params := expandUpdateUserPoolInput(d, tags)
 
-		if v, ok := d.GetOk("admin_create_user_config"); ok {
-			configs := v.([]interface{})
-			config, ok := configs[0].(map[string]interface{})
+		if err := updateUserPool(ctx, conn, params); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Cognito User pool (%s): %s", d.Id(), err)
+		}
+	}
 
-			if ok && config != nil {
-				params.AdminCreateUserConfig = expandUserPoolAdminCreateUserConfig(config)
+	if d.HasChange("schema") {
+		oldSchema, newSchema := d.GetChange("schema")
+		if oldSchema.(*schema.Set).Difference(newSchema.(*schema.Set)).Len() == 0 {
+			params := &cognitoidentityprovider.AddCustomAttributesInput{
+				UserPoolId:       aws.String(d.Id()),
+				CustomAttributes: expandUserPoolSchema(newSchema.(*schema.Set).Difference(oldSchema.(*schema.Set)).List()),
 			}
+			_, err := conn.AddCustomAttributesWithContext(ctx, params)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Cognito User Pool (%s): unable to add custom attributes from schema: %s", d.Id(), err)
+			}
+		} else {
+			return sdkdiag.AppendErrorf(diags, "updating Cognito User Pool (%s): cannot modify or remove schema items", d.Id())
 		}
+	}
 
-		if v, ok := d.GetOk("auto_verified_attributes"); ok {
-			params.AutoVerifiedAttributes = flex.ExpandStringSet(v.(*schema.Set))
-		}
+	return append(diags, resourceUserPoolRead(ctx, d, meta)...)
+}
 
-		if v, ok := d.GetOk("account_recovery_setting"); ok {
-			if config, ok := v.([]interface{})[0].(map[string]interface{}); ok {
-				params.AccountRecoverySetting = expandUserPoolAccountRecoverySettingConfig(config)
-			}
-		}
+func resourceUserPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
 
-		if v, ok := d.GetOk("deletion_protection"); ok {
-			params.DeletionProtection = aws.String(v.(string))
+	deletionProtection := d.Get("deletion_protection").(string) == cognitoidentityprovider.DeletionProtectionTypeActive
+	tags := tftags.New(d.Get("tags_all").(map[string]interface{}))
+
+	if deletionProtection {
+		if !d.Get("force_destroy").(bool) {
+			return sdkdiag.AppendErrorf(diags, "deleting Cognito user pool (%s): deletion protection is %s, set force_destroy to true or deletion_protection to %s", d.Id(), cognitoidentityprovider.DeletionProtectionTypeActive, cognitoidentityprovider.DeletionProtectionTypeInactive)
 		}
 
-		if v, ok := d.GetOk("device_configuration"); ok {
-			configs := v.([]interface{})
-			config, ok := configs[0].(map[string]interface{})
+		// A user pool with a domain can't be deleted, so don't turn off deletion protection.
+		output, err := conn.DescribeUserPoolWithContext(ctx, &cognitoidentityprovider.DescribeUserPoolInput{
+			UserPoolId: aws.String(d.Id()),
+		})
 
-			if ok && config != nil {
-				params.DeviceConfiguration = expandUserPoolDeviceConfiguration(config)
-			}
+		if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+			return diags
 		}
 
-		if v, ok := d.GetOk("email_configuration"); ok && len(v.([]interface{})) > 0 {
-			params.EmailConfiguration = expandUserPoolEmailConfig(v.([]interface{}))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Cognito user pool (%s): %s", d.Id(), err)
 		}
 
-		if v, ok := d.GetOk("email_verification_subject"); ok {
-			params.EmailVerificationSubject = aws.String(v.(string))
+		if userPool := output.UserPool; userPool != nil {
+			for _, v := range []*string{userPool.CustomDomain, userPool.Domain} {
+				if domain := aws.StringValue(v); domain != "" {
+					return sdkdiag.AppendErrorf(diags, "deleting Cognito user pool (%s): domain (%s) must be deleted first", d.Id(), domain)
+				}
+			}
 		}
 
-		if v, ok := d.GetOk("email_verification_message"); ok {
-			params.EmailVerificationMessage = aws.String(v.(string))
-		}
+		// Send all of the user pool's settings, so that none are reset if the deletion fails.
+		input := expandUpdateUserPoolInput(d, tags)
+		input.DeletionProtection = aws.String(cognitoidentityprovider.DeletionProtectionTypeInactive)
 
-		if v, ok := d.GetOk("lambda_config"); ok {
-			configs := v.([]interface{})
-			config, ok := configs[0].(map[string]interface{})
+		err = updateUserPool(ctx, conn, input)
 
-			if ok && config != nil {
-				params.LambdaConfig = expandUserPoolLambdaConfig(config)
-			}
+		if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+			return diags
 		}
 
-		if v, ok := d.GetOk("mfa_configuration"); ok {
-			params.MfaConfiguration = aws.String(v.(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "disabling Cognito user pool (%s) deletion protection: %s", d.Id(), err)
 		}
+	}
+
+	params := &cognitoidentityprovider.DeleteUserPoolInput{
+		UserPoolId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Cognito User Pool: %s", params)
+
+	var err error
+	if deletionProtection {
+		// Wait for the deletion protection change to propagate.
+		_, err = tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.DeleteUserPoolWithContext(ctx, params)
+		}, cognitoidentityprovider.ErrCodeInvalidParameterException, "deletion protection")
+	} else {
+		_, err = conn.DeleteUserPoolWithContext(ctx, params)
+	}
 
-		if v, ok := d.GetOk("password_policy"); ok {
-			configs := v.([]interface{})
-			config, ok := configs[0].(map[string]interface{})
+	if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+		return diags
+	}
 
-			if ok && config != nil {
-				policies := &cognitoidentityprovider.UserPoolPolicyType{}
-				policies.PasswordPolicy = expandUserPoolPasswordPolicy(config)
-				params.Policies = policies
+	if err != nil {
+		diags = sdkdiag.AppendErrorf(diags, "deleting Cognito user pool (%s): %s", d.Id(), err)
+
+		if deletionProtection {
+			// Don't leave the user pool unprotected.
+			if err := updateUserPool(ctx, conn, expandUpdateUserPoolInput(d, tags)); err != nil {
+				diags = sdkdiag.AppendErrorf(diags, "restoring Cognito user pool (%s) deletion protection: %s", d.Id(), err)
 			}
 		}
 
-		if v, ok := d.GetOk("sms_authentication_message"); ok {
-			params.SmsAuthenticationMessage = aws.String(v.(string))
-		}
+		return diags
+	}
 
-		if v, ok := d.GetOk("sms_configuration"); ok {
-			params.SmsConfiguration = expandSMSConfiguration(v.([]interface{}))
-		}
+	return diags
+}
 
-		if v, ok := d.GetOk("user_attribute_update_settings"); ok {
-			configs := v.([]interface{})
-			config, ok := configs[0].(map[string]interface{})
+// expandUpdateUserPoolInput returns an UpdateUserPool request for all of the user pool's settings.
+// UpdateUserPool resets any settings that are not specified.
+func expandUpdateUserPoolInput(d *schema.ResourceData, tags tftags.KeyValueTags) *cognitoidentityprovider.UpdateUserPoolInput {
+	params := &cognitoidentityprovider.UpdateUserPoolInput{
+		UserPoolId: aws.String(d.Id()),
+	}
 
-			if ok && config != nil {
-				params.UserAttributeUpdateSettings = expandUserPoolUserAttributeUpdateSettings(config)
-			}
+	if v, ok := d.GetOk("admin_create_user_config"); ok {
+		configs := v.([]interface{})
+		config, ok := configs[0].(map[string]interface{})
+
+		if ok && config != nil {
+			params.AdminCreateUserConfig = expandUserPoolAdminCreateUserConfig(config)
 		}
-		if d.HasChange("user_attribute_update_settings") && params.UserAttributeUpdateSettings == nil {
-			// An empty array must be sent to disable this setting if previously enabled. A nil
-			// UserAttibutesUpdateSetting param will result in no modifications.
-			params.UserAttributeUpdateSettings = &cognitoidentityprovider.UserAttributeUpdateSettingsType{
-				AttributesRequireVerificationBeforeUpdate: []*string{},
-			}
+	}
+
+	if v, ok := d.GetOk("auto_verified_attributes"); ok {
+		params.AutoVerifiedAttributes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("account_recovery_setting"); ok {
+		if config, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			params.AccountRecoverySetting = expandUserPoolAccountRecoverySettingConfig(config)
 		}
+	}
 
-		if v, ok := d.GetOk("user_pool_add_ons"); ok {
-			configs := v.([]interface{})
-			config, ok := configs[0].(map[string]interface{})
+	if v, ok := d.GetOk("deletion_protection"); ok {
+		params.DeletionProtection = aws.String(v.(string))
+	}
 
-			if ok && config != nil {
-				userPoolAddons := &cognitoidentityprovider.UserPoolAddOnsType{}
+	if v, ok := d.GetOk("device_configuration"); ok {
+		configs := v.([]interface{})
+		config, ok := configs[0].(map[string]interface{})
 
-				if v, ok := config["advanced_security_mode"]; ok && v.(string) != "" {
-					userPoolAddons.AdvancedSecurityMode = aws.String(v.(string))
-				}
-				params.UserPoolAddOns = userPoolAddons
-			}
+		if ok && config != nil {
+			params.DeviceConfiguration = expandUserPoolDeviceConfiguration(config)
 		}
+	}
 
-		if v, ok := d.GetOk("verification_message_template"); ok {
-			configs := v.([]interface{})
-			config, ok := configs[0].(map[string]interface{})
+	if v, ok := d.GetOk("email_configuration"); ok && len(v.([]interface{})) > 0 {
+		params.EmailConfiguration = expandUserPoolEmailConfig(v.([]interface{}))
+	}
 
-			if d.HasChange("email_verification_message") {
-				config["email_message"] = d.Get("email_verification_message")
-			}
-			if d.HasChange("email_verification_subject") {
-				config["email_subject"] = d.Get("email_verification_subject")
-			}
-			if d.HasChange("sms_verification_message") {
-				config["sms_message"] = d.Get("sms_verification_message")
-			}
+	if v, ok := d.GetOk("email_verification_subject"); ok {
+		params.EmailVerificationSubject = aws.String(v.(string))
+	}
 
-			if ok && config != nil {
-				params.VerificationMessageTemplate = expandUserPoolVerificationMessageTemplate(config)
-			}
-		}
+	if v, ok := d.GetOk("email_verification_message"); ok {
+		params.EmailVerificationMessage = aws.String(v.(string))
+	}
 
-		if v, ok := d.GetOk("sms_verification_message"); ok {
-			params.SmsVerificationMessage = aws.String(v.(string))
+	if v, ok := d.GetOk("lambda_config"); ok {
+		configs := v.([]interface{})
+		config, ok := configs[0].(map[string]interface{})
+
+		if ok && config != nil {
+			params.LambdaConfig = expandUserPoolLambdaConfig(config)
 		}
+	}
+
+	if v, ok := d.GetOk("mfa_configuration"); ok {
+		params.MfaConfiguration = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("password_policy"); ok {
+		configs := v.([]interface{})
+		config, ok := configs[0].(map[string]interface{})
 
-		if len(tags) > 0 {
-			params.UserPoolTags = Tags(tags.IgnoreAWS())
+		if ok && config != nil {
+			policies := &cognitoidentityprovider.UserPoolPolicyType{}
+			policies.PasswordPolicy = expandUserPoolPasswordPolicy(config)
+			params.Policies = policies
 		}
+	}
 
-		// IAM roles & policies can take some time to propagate and be attached
-		// to the User Pool.
-		err := resource.RetryContext(ctx, propagationTimeout, func() *resource.RetryError {
-			_, err := conn.UpdateUserPoolWithContext(ctx, params)
-			if tfawserr.ErrMessageContains(err, cognitoidentityprovider.ErrCodeInvalidSmsRoleTrustRelationshipException, "Role does not have a trust relationship allowing Cognito to assume the role") {
-				log.Printf("[DEBUG] Received %s, retrying UpdateUserPool", err)
-				return resource.RetryableError(err)
-			}
-			if tfawserr.ErrMessageContains(err, cognitoidentityprovider.ErrCodeInvalidSmsRoleAccessPolicyException, "Role does not have permission to publish with SNS") {
-				log.Printf("[DEBUG] Received %s, retrying UpdateUserPool", err)
-				return resource.RetryableError(err)
-			}
-			if tfawserr.ErrMessageContains(err, cognitoidentityprovider.ErrCodeInvalidParameterException, "Please use TemporaryPasswordValidityDays in PasswordPolicy instead of UnusedAccountValidityDays") {
-				log.Printf("[DEBUG] Received %s, retrying UpdateUserPool without UnusedAccountValidityDays", err)
-				params.AdminCreateUserConfig.UnusedAccountValidityDays = nil
-				return resource.RetryableError(err)
-			}
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
-		if tfresource.TimedOut(err) {
-			_, err = conn.UpdateUserPoolWithContext(ctx, params)
+	if v, ok := d.GetOk("sms_authentication_message"); ok {
+		params.SmsAuthenticationMessage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sms_configuration"); ok {
+		params.SmsConfiguration = expandSMSConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("user_attribute_update_settings"); ok {
+		configs := v.([]interface{})
+		config, ok := configs[0].(map[string]interface{})
+
+		if ok && config != nil {
+			params.UserAttributeUpdateSettings = expandUserPoolUserAttributeUpdateSettings(config)
 		}
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating Cognito User pool (%s): %s", d.Id(), err)
+	}
+	if d.HasChange("user_attribute_update_settings") && params.UserAttributeUpdateSettings == nil {
+		// An empty array must be sent to disable this setting if previously enabled. A nil
+		// UserAttibutesUpdateSetting param will result in no modifications.
+		params.UserAttributeUpdateSettings = &cognitoidentityprovider.UserAttributeUpdateSettingsType{
+			AttributesRequireVerificationBeforeUpdate: []*string{},
 		}
 	}
 
-	if d.HasChange("schema") {
-		oldSchema, newSchema := d.GetChange("schema")
-		if oldSchema.(*schema.Set).Difference(newSchema.(*schema.Set)).Len() == 0 {
-			params := &cognitoidentityprovider.AddCustomAttributesInput{
-				UserPoolId:       aws.String(d.Id()),
-				CustomAttributes: expandUserPoolSchema(newSchema.(*schema.Set).Difference(oldSchema.(*schema.Set)).List()),
-			}
-			_, err := conn.AddCustomAttributesWithContext(ctx, params)
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating Cognito User Pool (%s): unable to add custom attributes from schema: %s", d.Id(), err)
+	if v, ok := d.GetOk("user_pool_add_ons"); ok {
+		configs := v.([]interface{})
+		config, ok := configs[0].(map[string]interface{})
+
+		if ok && config != nil {
+			userPoolAddons := &cognitoidentityprovider.UserPoolAddOnsType{}
+
+			if v, ok := config["advanced_security_mode"]; ok && v.(string) != "" {
+				userPoolAddons.AdvancedSecurityMode = aws.String(v.(string))
 			}
-		} else {
-			return sdkdiag.AppendErrorf(diags, "updating Cognito User Pool (%s): cannot modify or remove schema items", d.Id())
+			params.UserPoolAddOns = userPoolAddons
 		}
 	}
 
-	return append(diags, resourceUserPoolRead(ctx, d, meta)...)
-}
+	if v, ok := d.GetOk("verification_message_template"); ok {
+		configs := v.([]interface{})
+		config, ok := configs[0].(map[string]interface{})
 
-func resourceUserPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).CognitoIDPConn()
+		if d.HasChange("email_verification_message") {
+			config["email_message"] = d.Get("email_verification_message")
+		}
+		if d.HasChange("email_verification_subject") {
+			config["email_subject"] = d.Get("email_verification_subject")
+		}
+		if d.HasChange("sms_verification_message") {
+			config["sms_message"] = d.Get("sms_verification_message")
+		}
 
-	params := &cognitoidentityprovider.DeleteUserPoolInput{
-		UserPoolId: aws.String(d.Id()),
+		if ok && config != nil {
+			params.VerificationMessageTemplate = expandUserPoolVerificationMessageTemplate(config)
+		}
 	}
 
-	log.Printf("[DEBUG] Deleting Cognito User Pool: %s", params)
-
-	_, err := conn.DeleteUserPoolWithContext(ctx, params)
+	if v, ok := d.GetOk("sms_verification_message"); ok {
+		params.SmsVerificationMessage = aws.String(v.(string))
+	}
 
-	if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
-		return diags
+	if len(tags) > 0 {
+		params.UserPoolTags = Tags(tags.IgnoreAWS())
 	}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting Cognito user pool (%s): %s", d.Id(), err)
+	return params
+}
+
+func updateUserPool(ctx context.Context, conn *cognitoidentityprovider.CognitoIdentityProvider, params *cognitoidentityprovider.UpdateUserPoolInput) error {
+	// IAM roles & policies can take some time to propagate and be attached
+	// to the User Pool.
+	err := resource.RetryContext(ctx, propagationTimeout, func() *resource.RetryError {
+		_, err := conn.UpdateUserPoolWithContext(ctx, params)
+		if tfawserr.ErrMessageContains(err, cognitoidentityprovider.ErrCodeInvalidSmsRoleTrustRelationshipException, "Role does not have a trust relationship allowing Cognito to assume the role") {
+			log.Printf("[DEBUG] Received %s, retrying UpdateUserPool", err)
+			return resource.RetryableError(err)
+		}
+		if tfawserr.ErrMessageContains(err, cognitoidentityprovider.ErrCodeInvalidSmsRoleAccessPolicyException, "Role does not have permission to publish with SNS") {
+			log.Printf("[DEBUG] Received %s, retrying UpdateUserPool", err)
+			return resource.RetryableError(err)
+		}
+		if tfawserr.ErrMessageContains(err, cognitoidentityprovider.ErrCodeInvalidParameterException, "Please use TemporaryPasswordValidityDays in PasswordPolicy instead of UnusedAccountValidityDays") {
+			log.Printf("[DEBUG] Received %s, retrying UpdateUserPool without UnusedAccountValidityDays", err)
+			params.AdminCreateUserConfig.UnusedAccountValidityDays = nil
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if tfresource.TimedOut(err) {
+		_, err = conn.UpdateUserPoolWithContext(ctx, params)
 	}
 
-	return diags
+	return err
 }
 
 func expandSMSConfiguration(tfList []interface{}) *cognitoidentityprovider.SmsConfigurationType {
@@ -556,20 +556,22 @@ func expandUserPoolClientAnalyticsConfig(l []interface{}) *cognitoidentityprovid
 
 	analyticsConfig := &cognitoidentityprovider.AnalyticsConfigurationType{}
 
-	if v, ok := m["role_arn"]; ok && v != "" {
-		analyticsConfig.RoleArn = aws.String(v.(string))
-	}
-
-	if v, ok := m["external_id"]; ok && v != "" {
-		analyticsConfig.ExternalId = aws.String(v.(string))
-	}
-
-	if v, ok := m["application_id"]; ok && v != "" {
-		analyticsConfig.ApplicationId = aws.String(v.(string))
-	}
-
 	if v, ok := m["application_arn"]; ok && v != "" {
 		analyticsConfig.ApplicationArn = aws.String(v.(string))
+	} else {
+		// role_arn is computed when application_arn is used, so a role ARN carried over
+		// in state must not be sent along with the application ARN.
+		if v, ok := m["role_arn"]; ok && v != "" {
+			analyticsConfig.RoleArn = aws.String(v.(string))
+		}
+
+		if v, ok := m["external_id"]; ok && v != "" {
+			analyticsConfig.ExternalId = aws.String(v.(string))
+		}
+
+		if v, ok := m["application_id"]; ok && v != "" {
+			analyticsConfig.ApplicationId = aws.String(v.(string))
+		}
 	}
 
 	if v, ok := m["user_data_shared"]; ok {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -23,6 +24,7 @@ func ResourceUserPoolDomain() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserPoolDomainCreate,
 		ReadWithoutTimeout:   resourceUserPoolDomainRead,
+		UpdateWithoutTimeout: resourceUserPoolDomainUpdate,
 		DeleteWithoutTimeout: resourceUserPoolDomainDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -38,7 +40,6 @@ func ResourceUserPoolDomain() *schema.Resource {
 			"certificate_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
 			"user_pool_id": {
@@ -50,7 +51,16 @@ func ResourceUserPoolDomain() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"cloudfront_distribution": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"cloudfront_distribution_arn": {
+				Type:       schema.TypeString,
+				Computed:   true,
+				Deprecated: "Use cloudfront_distribution instead",
+			},
+			"cloudfront_distribution_zone_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
@@ -63,6 +73,8 @@ func ResourceUserPoolDomain() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: resourceUserPoolDomainCustomizeDiff,
 	}
 }
 
@@ -139,7 +151,9 @@ func resourceUserPoolDomainRead(ctx context.Context, d *schema.ResourceData, met
 		d.Set("certificate_arn", desc.CustomDomainConfig.CertificateArn)
 	}
 	d.Set("aws_account_id", desc.AWSAccountId)
+	d.Set("cloudfront_distribution", desc.CloudFrontDistribution)
 	d.Set("cloudfront_distribution_arn", desc.CloudFrontDistribution)
+	d.Set("cloudfront_distribution_zone_id", tfcloudfront.HostedZoneIDForPartition(meta.(*conns.AWSClient).Partition))
 	d.Set("s3_bucket", desc.S3Bucket)
 	d.Set("user_pool_id", desc.UserPoolId)
 	d.Set("version", desc.Version)
@@ -147,6 +161,33 @@ func resourceUserPoolDomainRead(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+func resourceUserPoolDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
+
+	if d.HasChange("certificate_arn") {
+		input := &cognitoidentityprovider.UpdateUserPoolDomainInput{
+			CustomDomainConfig: &cognitoidentityprovider.CustomDomainConfigType{
+				CertificateArn: aws.String(d.Get("certificate_arn").(string)),
+			},
+			Domain:     aws.String(d.Id()),
+			UserPoolId: aws.String(d.Get("user_pool_id").(string)),
+		}
+
+		_, err := conn.UpdateUserPoolDomainWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Cognito User Pool Domain (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitUserPoolDomainUpdated(ctx, conn, d.Id(), userPoolDomainUpdateTimeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for User Pool Domain (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceUserPoolDomainRead(ctx, d, meta)...)
+}
+
 func resourceUserPoolDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CognitoIDPConn()
@@ -169,3 +210,23 @@ func resourceUserPoolDomainDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return diags
 }
+
+func resourceUserPoolDomainCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("certificate_arn") {
+		return nil
+	}
+
+	// A prefix domain can't be turned into a custom domain, or vice versa.
+	// An unknown new value (e.g. a certificate being created) is treated as a certificate.
+	o, n := diff.GetChange("certificate_arn")
+	if o.(string) == "" || (diff.NewValueKnown("certificate_arn") && n.(string) == "") {
+		return diff.ForceNew("certificate_arn")
+	}
+
+	// Updating the certificate updates the custom domain's CloudFront distribution.
+	if err := diff.SetNewComputed("cloudfront_distribution"); err != nil {
+		return err
+	}
+
+	return diff.SetNewComputed("cloudfront_distribution_arn")
+}
@@ -74,6 +74,8 @@ func TestAccCognitoIDPUserPoolDomain_custom(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "certificate_arn", acmCertificateResourceName, "arn"),
 					//lintignore:AWSAT001 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/11666
 					resource.TestMatchResourceAttr(resourceName, "cloudfront_distribution_arn", regexp.MustCompile(`[a-z0-9]+.cloudfront.net$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "cloudfront_distribution", resourceName, "cloudfront_distribution_arn"),
+					resource.TestCheckResourceAttr(resourceName, "cloudfront_distribution_zone_id", "Z2FDTNDATAQYW2"),
 					resource.TestCheckResourceAttrPair(resourceName, "domain", acmCertificateResourceName, "domain_name"),
 					resource.TestMatchResourceAttr(resourceName, "s3_bucket", regexp.MustCompile(`^.+$`)),
 					resource.TestCheckResourceAttrPair(resourceName, "user_pool_id", cognitoUserPoolResourceName, "id"),
@@ -89,6 +91,49 @@ func TestAccCognitoIDPUserPoolDomain_custom(t *testing.T) {
 	})
 }
 
+func TestAccCognitoIDPUserPoolDomain_customCertUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	poolName := fmt.Sprintf("tf-acc-test-pool-%s", sdkacctest.RandString(10))
+
+	acmCertificateResourceName := "aws_acm_certificate.test"
+	acmCertificate2ResourceName := "aws_acm_certificate.test2"
+	resourceName := "aws_cognito_user_pool_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckUserPoolCustomDomain(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserPoolDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPoolDomainConfig_customCertUpdate(rootDomain, domain, poolName, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserPoolDomainExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_arn", acmCertificateResourceName, "arn"),
+					//lintignore:AWSAT001 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/11666
+					resource.TestMatchResourceAttr(resourceName, "cloudfront_distribution", regexp.MustCompile(`[a-z0-9]+.cloudfront.net$`)),
+				),
+			},
+			{
+				Config: testAccUserPoolDomainConfig_customCertUpdate(rootDomain, domain, poolName, "test2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserPoolDomainExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_arn", acmCertificate2ResourceName, "arn"),
+					//lintignore:AWSAT001 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/11666
+					resource.TestMatchResourceAttr(resourceName, "cloudfront_distribution", regexp.MustCompile(`[a-z0-9]+.cloudfront.net$`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccCognitoIDPUserPoolDomain_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	domainName := fmt.Sprintf("tf-acc-test-domain-%d", sdkacctest.RandInt())
@@ -232,3 +277,54 @@ resource "aws_cognito_user_pool_domain" "test" {
 }
 `, rootDomain, domain, poolName))
 }
+
+func testAccUserPoolDomainConfig_customCertUpdate(rootDomain, domain, poolName, certificateResourceName string) string {
+	return acctest.ConfigCompose(
+		testAccUserPoolCustomDomainRegionProviderConfig(),
+		fmt.Sprintf(`
+data "aws_route53_zone" "test" {
+  name         = %[1]q
+  private_zone = false
+}
+
+resource "aws_acm_certificate" "test" {
+  domain_name       = %[2]q
+  validation_method = "DNS"
+}
+
+resource "aws_acm_certificate" "test2" {
+  domain_name       = %[2]q
+  validation_method = "DNS"
+}
+
+# Both certificates are for the same domain name, so share the same validation record.
+resource "aws_route53_record" "test" {
+  allow_overwrite = true
+  name            = tolist(aws_acm_certificate.test.domain_validation_options)[0].resource_record_name
+  records         = [tolist(aws_acm_certificate.test.domain_validation_options)[0].resource_record_value]
+  ttl             = 60
+  type            = tolist(aws_acm_certificate.test.domain_validation_options)[0].resource_record_type
+  zone_id         = data.aws_route53_zone.test.zone_id
+}
+
+resource "aws_acm_certificate_validation" "test" {
+  certificate_arn         = aws_acm_certificate.test.arn
+  validation_record_fqdns = [aws_route53_record.test.fqdn]
+}
+
+resource "aws_acm_certificate_validation" "test2" {
+  certificate_arn         = aws_acm_certificate.test2.arn
+  validation_record_fqdns = [aws_route53_record.test.fqdn]
+}
+
+resource "aws_cognito_user_pool" "test" {
+  name = %[3]q
+}
+
+resource "aws_cognito_user_pool_domain" "test" {
+  certificate_arn = aws_acm_certificate_validation.%[4]s.certificate_arn
+  domain          = %[2]q
+  user_pool_id    = aws_cognito_user_pool.test.id
+}
+`, rootDomain, domain, poolName, certificateResourceName))
+}
@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
@@ -116,6 +117,74 @@ func TestAccCognitoIDPUserPool_deletionProtection(t *testing.T) {
 	})
 }
 
+func TestAccCognitoIDPUserPool_deletionProtectionForceDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cognito_user_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPoolConfig_deletionProtectionForceDestroy(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPUserPool_deletionProtectionForceDestroyFailure(t *testing.T) {
+	ctx := acctest.Context(t)
+	var pool cognitoidentityprovider.DescribeUserPoolOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cognito_user_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPoolConfig_deletionProtectionForceDestroyVerification(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserPoolExists(ctx, resourceName, &pool),
+					// A user pool with a domain can't be deleted.
+					testAccCheckUserPoolCreateDomain(ctx, &pool, rName),
+				),
+			},
+			{
+				Config:      testAccUserPoolConfig_none(),
+				ExpectError: regexp.MustCompile(`must be deleted first`),
+			},
+			{
+				PreConfig: func() {
+					testAccDeleteUserPoolDomain(ctx, t, &pool, rName)
+				},
+				Config: testAccUserPoolConfig_deletionProtectionForceDestroyVerification(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserPoolExists(ctx, resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "email_verification_subject", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "email_verification_message", "{####} foo"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCognitoIDPUserPool_recovery(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1607,6 +1676,52 @@ func testAccCheckUserPoolExists(ctx context.Context, name string, pool *cognitoi
 	}
 }
 
+func testAccCheckUserPoolCreateDomain(ctx context.Context, pool *cognitoidentityprovider.DescribeUserPoolOutput, domain string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn()
+
+		_, err := conn.CreateUserPoolDomainWithContext(ctx, &cognitoidentityprovider.CreateUserPoolDomainInput{
+			Domain:     aws.String(domain),
+			UserPoolId: pool.UserPool.Id,
+		})
+
+		return err
+	}
+}
+
+func testAccDeleteUserPoolDomain(ctx context.Context, t *testing.T, pool *cognitoidentityprovider.DescribeUserPoolOutput, domain string) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn()
+
+	_, err := conn.DeleteUserPoolDomainWithContext(ctx, &cognitoidentityprovider.DeleteUserPoolDomainInput{
+		Domain:     aws.String(domain),
+		UserPoolId: pool.UserPool.Id,
+	})
+
+	if err != nil {
+		t.Fatalf("deleting Cognito User Pool Domain (%s): %s", domain, err)
+	}
+
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+		output, err := conn.DescribeUserPoolWithContext(ctx, &cognitoidentityprovider.DescribeUserPoolInput{
+			UserPoolId: pool.UserPool.Id,
+		})
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if aws.StringValue(output.UserPool.Domain) != "" {
+			return resource.RetryableError(fmt.Errorf("Cognito User Pool Domain (%s) still exists", domain))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("waiting for Cognito User Pool Domain (%s) deletion: %s", domain, err)
+	}
+}
+
 func testAccPreCheckIdentityProvider(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn()
 
@@ -1682,6 +1797,34 @@ resource "aws_cognito_user_pool" "test" {
 `, rName, active)
 }
 
+func testAccUserPoolConfig_deletionProtectionForceDestroy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name                = %[1]q
+  deletion_protection = "ACTIVE"
+  force_destroy       = true
+}
+`, rName)
+}
+
+func testAccUserPoolConfig_deletionProtectionForceDestroyVerification(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name                       = %[1]q
+  deletion_protection        = "ACTIVE"
+  force_destroy              = true
+  email_verification_subject = "foo"
+  email_verification_message = "{####} foo"
+}
+`, rName)
+}
+
+func testAccUserPoolConfig_none() string {
+	return `
+data "aws_partition" "current" {}
+`
+}
+
 func testAccUserPoolConfig_accountRecoverySingle(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cognito_user_pool" "test" {
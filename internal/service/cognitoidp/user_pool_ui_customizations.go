@@ -0,0 +1,365 @@
+package cognitoidp
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func ResourceUserPoolUICustomizations() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserPoolUICustomizationsPut,
+		ReadWithoutTimeout:   resourceUserPoolUICustomizationsRead,
+		UpdateWithoutTimeout: resourceUserPoolUICustomizationsPut,
+		DeleteWithoutTimeout: resourceUserPoolUICustomizationsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserPoolUICustomizationsImport,
+		},
+
+		CustomizeDiff: resourceUserPoolUICustomizationsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"customization": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Set:      userPoolUICustomizationHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"creation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"css": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"css_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"image_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"user_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUserPoolUICustomizationsPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
+
+	userPoolId := d.Get("user_pool_id").(string)
+
+	var imgFile []byte
+	if v, ok := d.GetOk("image_file"); ok {
+		var err error
+		imgFile, err = base64.StdEncoding.DecodeString(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "Base64 decoding image file for Cognito User Pool UI customizations (UserPoolId: %s): %s", userPoolId, err)
+		}
+	}
+
+	clientIds := make(map[string]struct{})
+
+	for _, tfMapRaw := range d.Get("customization").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		clientId := tfMap["client_id"].(string)
+		clientIds[clientId] = struct{}{}
+
+		input := &cognitoidentityprovider.SetUICustomizationInput{
+			ClientId:   aws.String(clientId),
+			ImageFile:  imgFile,
+			UserPoolId: aws.String(userPoolId),
+		}
+
+		if v, ok := tfMap["css"].(string); ok && v != "" {
+			input.CSS = aws.String(v)
+		}
+
+		_, err := conn.SetUICustomizationWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Cognito User Pool UI customization (UserPoolId: %s, ClientId: %s): %s", userPoolId, clientId, err)
+		}
+	}
+
+	if d.HasChange("customization") {
+		o, _ := d.GetChange("customization")
+
+		for _, clientId := range userPoolUICustomizationsClientIDs(o.(*schema.Set).List()) {
+			if _, ok := clientIds[clientId]; ok {
+				continue
+			}
+
+			if err := resetUserPoolUICustomization(ctx, conn, userPoolId, clientId); err != nil {
+				return sdkdiag.AppendErrorf(diags, "deleting Cognito User Pool UI customization (UserPoolId: %s, ClientId: %s): %s", userPoolId, clientId, err)
+			}
+		}
+	}
+
+	d.SetId(userPoolId)
+
+	return append(diags, resourceUserPoolUICustomizationsRead(ctx, d, meta)...)
+}
+
+func resourceUserPoolUICustomizationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
+
+	userPoolId := d.Id()
+	clientIds := userPoolUICustomizationsClientIDs(d.Get("customization").(*schema.Set).List())
+
+	var customizations []interface{}
+
+	for _, clientId := range clientIds {
+		uiCustomization, err := FindCognitoUserPoolUICustomization(ctx, conn, userPoolId, clientId)
+
+		if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+			log.Printf("[WARN] Cognito User Pool UI customization (UserPoolId: %s, ClientId: %s) not found, removing from state", userPoolId, clientId)
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "getting Cognito User Pool UI customization (UserPoolId: %s, ClientId: %s): %s", userPoolId, clientId, err)
+		}
+
+		// GetUICustomization falls back to the user pool's ALL customization
+		// for app clients without a customization of their own, and a removed
+		// customization leaves its CSS version behind.
+		if uiCustomization == nil || aws.StringValue(uiCustomization.ClientId) != clientId || (uiCustomization.CSS == nil && uiCustomization.ImageUrl == nil) {
+			if d.IsNewResource() {
+				return sdkdiag.AppendErrorf(diags, "getting Cognito User Pool UI customization (UserPoolId: %s, ClientId: %s): not found", userPoolId, clientId)
+			}
+
+			log.Printf("[WARN] Cognito User Pool UI customization (UserPoolId: %s, ClientId: %s) not found, removing from state", userPoolId, clientId)
+			continue
+		}
+
+		customizations = append(customizations, map[string]interface{}{
+			"client_id":          aws.StringValue(uiCustomization.ClientId),
+			"creation_date":      aws.TimeValue(uiCustomization.CreationDate).Format(time.RFC3339),
+			"css":                aws.StringValue(uiCustomization.CSS),
+			"css_version":        aws.StringValue(uiCustomization.CSSVersion),
+			"image_url":          aws.StringValue(uiCustomization.ImageUrl),
+			"last_modified_date": aws.TimeValue(uiCustomization.LastModifiedDate).Format(time.RFC3339),
+		})
+	}
+
+	if len(customizations) == 0 {
+		log.Printf("[WARN] Cognito User Pool UI customizations (%s) not found, removing from state", userPoolId)
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set("customization", customizations); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting customization: %s", err)
+	}
+	d.Set("user_pool_id", userPoolId)
+
+	return diags
+}
+
+func resourceUserPoolUICustomizationsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
+
+	userPoolId := d.Id()
+
+	for _, clientId := range userPoolUICustomizationsClientIDs(d.Get("customization").(*schema.Set).List()) {
+		err := resetUserPoolUICustomization(ctx, conn, userPoolId, clientId)
+
+		if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting Cognito User Pool UI customization (UserPoolId: %s, ClientId: %s): %s", userPoolId, clientId, err)
+		}
+	}
+
+	return diags
+}
+
+// resourceUserPoolUICustomizationsImport discovers the customizations of the user pool and each of its app clients.
+func resourceUserPoolUICustomizationsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
+
+	userPoolId := d.Id()
+	customizations := []interface{}{
+		map[string]interface{}{
+			"client_id": "ALL",
+		},
+	}
+
+	input := &cognitoidentityprovider.ListUserPoolClientsInput{
+		UserPoolId: aws.String(userPoolId),
+	}
+
+	err := conn.ListUserPoolClientsPagesWithContext(ctx, input, func(page *cognitoidentityprovider.ListUserPoolClientsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.UserPoolClients {
+			if v != nil {
+				customizations = append(customizations, map[string]interface{}{
+					"client_id": aws.StringValue(v.ClientId),
+				})
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("listing Cognito User Pool Clients (%s): %w", userPoolId, err)
+	}
+
+	if err := d.Set("customization", customizations); err != nil {
+		return nil, fmt.Errorf("setting customization: %w", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceUserPoolUICustomizationsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := diff.GetRawConfig()
+
+	if !config.IsKnown() || config.IsNull() {
+		return nil
+	}
+
+	customizations := config.GetAttr("customization")
+
+	if !customizations.IsKnown() || customizations.IsNull() {
+		return nil
+	}
+
+	imageFile := config.GetAttr("image_file")
+	clientIds := make(map[string]struct{})
+
+	for it := customizations.ElementIterator(); it.Next(); {
+		_, v := it.Element()
+
+		if !v.IsKnown() || v.IsNull() {
+			continue
+		}
+
+		clientId := v.GetAttr("client_id")
+
+		if !clientId.IsKnown() || clientId.IsNull() {
+			continue
+		}
+
+		if _, ok := clientIds[clientId.AsString()]; ok {
+			return fmt.Errorf("duplicate customization for client_id %q", clientId.AsString())
+		}
+
+		clientIds[clientId.AsString()] = struct{}{}
+
+		if css := v.GetAttr("css"); userPoolUICustomizationValueEmpty(css) && userPoolUICustomizationValueEmpty(imageFile) {
+			return fmt.Errorf("customization for client_id %q: at least one of css or image_file is required", clientId.AsString())
+		}
+	}
+
+	return nil
+}
+
+// userPoolUICustomizationValueEmpty returns whether a configured string is known to be null or empty.
+func userPoolUICustomizationValueEmpty(v cty.Value) bool {
+	return v.IsKnown() && (v.IsNull() || v.AsString() == "")
+}
+
+func userPoolUICustomizationHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	tfMap, ok := v.(map[string]interface{})
+
+	if !ok {
+		return 0
+	}
+
+	if v, ok := tfMap["client_id"].(string); ok {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
+
+	if v, ok := tfMap["css"].(string); ok {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
+
+	return create.StringHashcode(buf.String())
+}
+
+// resetUserPoolUICustomization removes a UI customization by setting it with neither CSS nor an image.
+func resetUserPoolUICustomization(ctx context.Context, conn *cognitoidentityprovider.CognitoIdentityProvider, userPoolId, clientId string) error {
+	input := &cognitoidentityprovider.SetUICustomizationInput{
+		ClientId:   aws.String(clientId),
+		UserPoolId: aws.String(userPoolId),
+	}
+
+	_, err := conn.SetUICustomizationWithContext(ctx, input)
+
+	return err
+}
+
+func userPoolUICustomizationsClientIDs(tfList []interface{}) []string {
+	var clientIds []string
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["client_id"].(string); ok && v != "" {
+			clientIds = append(clientIds, v)
+		}
+	}
+
+	return clientIds
+}
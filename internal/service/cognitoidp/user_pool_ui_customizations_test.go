@@ -0,0 +1,234 @@
+package cognitoidp_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcognitoidp "github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidp"
+)
+
+func TestAccCognitoIDPUserPoolUICustomizations_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cognito_user_pool_ui_customizations.test"
+	userPoolResourceName := "aws_cognito_user_pool.test"
+
+	css := ".label-customizable {font-weight: 400;}"
+	cssUpdated := ".label-customizable {font-weight: 100;}"
+	filename := "testdata/logo.png"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserPoolUICustomizationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPoolUICustomizationsConfig_basic(rName, css, cssUpdated, filename),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserPoolUICustomizationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "customization.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "customization.*.client_id", "aws_cognito_user_pool_client.test.0", "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "customization.*.client_id", "aws_cognito_user_pool_client.test.1", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "customization.*", map[string]string{
+						"css": css,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "customization.*", map[string]string{
+						"css": cssUpdated,
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "user_pool_id", userPoolResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"image_file"},
+			},
+			{
+				Config: testAccUserPoolUICustomizationsConfig_single(rName, css, filename),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserPoolUICustomizationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "customization.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "customization.*.client_id", "aws_cognito_user_pool_client.test.0", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "customization.*", map[string]string{
+						"css": css,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPUserPoolUICustomizations_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cognito_user_pool_ui_customizations.test"
+
+	css := ".label-customizable {font-weight: 400;}"
+	filename := "testdata/logo.png"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserPoolUICustomizationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPoolUICustomizationsConfig_single(rName, css, filename),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserPoolUICustomizationsExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcognitoidp.ResourceUserPoolUICustomizations(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckUserPoolUICustomizationsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cognito_user_pool_ui_customizations" {
+				continue
+			}
+
+			for _, clientId := range testAccUserPoolUICustomizationsClientIDs(rs) {
+
+				output, err := tfcognitoidp.FindCognitoUserPoolUICustomization(ctx, conn, rs.Primary.ID, clientId)
+
+				if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+					continue
+				}
+
+				// Catch cases where the User Pool Domain has been destroyed, effectively eliminating
+				// a UI customization; calls to GetUICustomization will fail
+				if tfawserr.ErrMessageContains(err, cognitoidentityprovider.ErrCodeInvalidParameterException, "There has to be an existing domain associated with this user pool") {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				if output != nil && output.ClientId != nil && *output.ClientId == clientId && testAccUserPoolUICustomizationExists(output) {
+					return fmt.Errorf("Cognito User Pool UI Customization (UserPoolId: %s, ClientId: %s) still exists", rs.Primary.ID, clientId)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckUserPoolUICustomizationsExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cognito User Pool UI customizations ID set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn()
+
+		for _, clientId := range testAccUserPoolUICustomizationsClientIDs(rs) {
+
+			output, err := tfcognitoidp.FindCognitoUserPoolUICustomization(ctx, conn, rs.Primary.ID, clientId)
+
+			if err != nil {
+				return err
+			}
+
+			if output == nil {
+				return fmt.Errorf("Cognito User Pool UI customization (UserPoolId: %s, ClientId: %s) not found", rs.Primary.ID, clientId)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccUserPoolUICustomizationsClientIDs(rs *terraform.ResourceState) []string {
+	var clientIds []string
+
+	for k, v := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, "customization.") && strings.HasSuffix(k, ".client_id") {
+			clientIds = append(clientIds, v)
+		}
+	}
+
+	return clientIds
+}
+
+func testAccUserPoolUICustomizationsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_cognito_user_pool_domain" "test" {
+  domain       = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+resource "aws_cognito_user_pool_client" "test" {
+  count = 2
+
+  name         = "%[1]s-${count.index}"
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+`, rName)
+}
+
+func testAccUserPoolUICustomizationsConfig_basic(rName, css1, css2, filename string) string {
+	return acctest.ConfigCompose(testAccUserPoolUICustomizationsConfig_base(rName), fmt.Sprintf(`
+resource "aws_cognito_user_pool_ui_customizations" "test" {
+  image_file = filebase64(%[3]q)
+
+  customization {
+    client_id = aws_cognito_user_pool_client.test[0].id
+    css       = %[1]q
+  }
+
+  customization {
+    client_id = aws_cognito_user_pool_client.test[1].id
+    css       = %[2]q
+  }
+
+  # Refer to the aws_cognito_user_pool_domain resource's
+  # user_pool_id attribute to ensure it is in an 'Active' state
+  user_pool_id = aws_cognito_user_pool_domain.test.user_pool_id
+}
+`, css1, css2, filename))
+}
+
+func testAccUserPoolUICustomizationsConfig_single(rName, css, filename string) string {
+	return acctest.ConfigCompose(testAccUserPoolUICustomizationsConfig_base(rName), fmt.Sprintf(`
+resource "aws_cognito_user_pool_ui_customizations" "test" {
+  image_file = filebase64(%[2]q)
+
+  customization {
+    client_id = aws_cognito_user_pool_client.test[0].id
+    css       = %[1]q
+  }
+
+  # Refer to the aws_cognito_user_pool_domain resource's
+  # user_pool_id attribute to ensure it is in an 'Active' state
+  user_pool_id = aws_cognito_user_pool_domain.test.user_pool_id
+}
+`, css, filename))
+}
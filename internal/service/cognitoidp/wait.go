@@ -11,6 +11,8 @@ import (
 const (
 	// Maximum amount of time to wait for an Operation to return Success
 	userPoolDomainDeleteTimeout = 1 * time.Minute
+	// Custom domain updates wait on the CloudFront distribution to deploy
+	userPoolDomainUpdateTimeout = 60 * time.Minute
 )
 
 // waitUserPoolDomainDeleted waits for an Operation to return Success
@@ -20,7 +22,7 @@ func waitUserPoolDomainDeleted(ctx context.Context, conn *cognitoidentityprovide
 			cognitoidentityprovider.DomainStatusTypeUpdating,
 			cognitoidentityprovider.DomainStatusTypeDeleting,
 		},
-		Target:  []string{""},
+		Target:  []string{},
 		Refresh: statusUserPoolDomain(ctx, conn, domain),
 		Timeout: userPoolDomainDeleteTimeout,
 	}
@@ -55,3 +57,28 @@ func waitUserPoolDomainCreated(ctx context.Context, conn *cognitoidentityprovide
 
 	return nil, err
 }
+
+func waitUserPoolDomainUpdated(ctx context.Context, conn *cognitoidentityprovider.CognitoIdentityProvider, domain string, timeout time.Duration) (*cognitoidentityprovider.DescribeUserPoolDomainOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cognitoidentityprovider.DomainStatusTypeCreating,
+			cognitoidentityprovider.DomainStatusTypeUpdating,
+		},
+		Target: []string{
+			cognitoidentityprovider.DomainStatusTypeActive,
+		},
+		Refresh: statusUserPoolDomain(ctx, conn, domain),
+		Timeout: timeout,
+		// The domain may still be ACTIVE immediately after the update request.
+		Delay:                     10 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cognitoidentityprovider.DescribeUserPoolDomainOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
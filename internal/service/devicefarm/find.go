@@ -153,3 +153,34 @@ func FindTestGridProjectByARN(ctx context.Context, conn *devicefarm.DeviceFarm,
 
 	return output.TestGridProject, nil
 }
+
+func FindTestGridSessionArtifacts(ctx context.Context, conn *devicefarm.DeviceFarm, input *devicefarm.ListTestGridSessionArtifactsInput) ([]*devicefarm.TestGridSessionArtifact, error) {
+	var output []*devicefarm.TestGridSessionArtifact
+
+	err := conn.ListTestGridSessionArtifactsPagesWithContext(ctx, input, func(page *devicefarm.ListTestGridSessionArtifactsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Artifacts {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, devicefarm.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
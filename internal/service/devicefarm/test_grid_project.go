@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -71,7 +72,13 @@ func ResourceTestGridProject() *schema.Resource {
 				},
 			},
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			// The API can change the VPC configuration of a project but cannot remove it.
+			customdiff.ForceNewIfChange("vpc_config", func(_ context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+			}),
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -176,6 +183,10 @@ func resourceTestGridProjectUpdate(ctx context.Context, d *schema.ResourceData,
 			input.Description = aws.String(d.Get("description").(string))
 		}
 
+		if d.HasChange("vpc_config") {
+			input.VpcConfig = expandTestGridProjectVPCConfig(d.Get("vpc_config").([]interface{}))
+		}
+
 		log.Printf("[DEBUG] Updating DeviceFarm Test Grid Project: %s", d.Id())
 		_, err := conn.UpdateTestGridProjectWithContext(ctx, input)
 		if err != nil {
@@ -88,6 +88,7 @@ func TestAccDeviceFarmTestGridProject_vpc(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceName, "vpc_config.0.vpc_id", "aws_vpc.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.security_group_ids.#", "2"),
 				),
 			},
 			{
@@ -95,6 +96,16 @@ func TestAccDeviceFarmTestGridProject_vpc(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccTestGridProjectConfig_projectVPCUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectTestGridProjectExists(ctx, resourceName, &proj),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_config.0.vpc_id", "aws_vpc.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.subnet_ids.#", "1"),
+				),
+			},
 		},
 	})
 }
@@ -283,6 +294,47 @@ resource "aws_devicefarm_test_grid_project" "test" {
 `, rName))
 }
 
+func testAccTestGridProjectConfig_projectVPCUpdated(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_subnet" "test" {
+  count             = 2
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = "10.0.${count.index}.0/24"
+  vpc_id            = aws_vpc.test.id
+}
+
+resource "aws_security_group" "test" {
+  count = 2
+
+  name        = "%[1]s-${count.index}"
+  description = "Allow all inbound traffic"
+  vpc_id      = aws_vpc.test.id
+  ingress {
+    protocol    = "6"
+    from_port   = 80
+    to_port     = 8000
+    cidr_blocks = [aws_vpc.test.cidr_block]
+  }
+}
+
+resource "aws_devicefarm_test_grid_project" "test" {
+  name = %[1]q
+
+  vpc_config {
+    vpc_id             = aws_vpc.test.id
+    subnet_ids         = [aws_subnet.test[0].id]
+    security_group_ids = [aws_security_group.test[1].id]
+  }
+}
+`, rName))
+}
+
 func testAccTestGridProjectConfig_projectTags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_devicefarm_test_grid_project" "test" {
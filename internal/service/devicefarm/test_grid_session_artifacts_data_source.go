@@ -0,0 +1,104 @@
+package devicefarm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceTestGridSessionArtifacts() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTestGridSessionArtifactsRead,
+
+		Schema: map[string]*schema.Schema{
+			"artifacts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filename": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"session_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(devicefarm.TestGridSessionArtifactCategory_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceTestGridSessionArtifactsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeviceFarmConn()
+
+	sessionARN := d.Get("session_arn").(string)
+	input := &devicefarm.ListTestGridSessionArtifactsInput{
+		SessionArn: aws.String(sessionARN),
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		input.Type = aws.String(v.(string))
+	}
+
+	artifacts, err := FindTestGridSessionArtifacts(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DeviceFarm Test Grid Session (%s) artifacts: %s", sessionARN, err)
+	}
+
+	d.SetId(sessionARN)
+
+	if err := d.Set("artifacts", flattenTestGridSessionArtifacts(artifacts)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting artifacts: %s", err)
+	}
+
+	return diags
+}
+
+func flattenTestGridSessionArtifacts(apiObjects []*devicefarm.TestGridSessionArtifact) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"filename": aws.StringValue(apiObject.Filename),
+			"type":     aws.StringValue(apiObject.Type),
+			"url":      aws.StringValue(apiObject.Url),
+		})
+	}
+
+	return tfList
+}
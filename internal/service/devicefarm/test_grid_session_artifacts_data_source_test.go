@@ -0,0 +1,87 @@
+package devicefarm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+const (
+	envVarTestGridSessionARN             = "AWS_DEVICEFARM_TEST_GRID_SESSION_ARN"
+	envVarTestGridSessionARNMessageError = "Environment variable AWS_DEVICEFARM_TEST_GRID_SESSION_ARN is not set. " +
+		"Test Grid sessions are started by Selenium clients and cannot be created by Terraform, so an existing session ARN must be provided."
+)
+
+func TestAccDeviceFarmTestGridSessionArtifactsDataSource_basic(t *testing.T) {
+	sessionARN := envvar.SkipIfEmpty(t, envVarTestGridSessionARN, envVarTestGridSessionARNMessageError)
+	dataSourceName := "data.aws_devicefarm_test_grid_session_artifacts.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(devicefarm.EndpointsID, t)
+			// Currently, DeviceFarm is only supported in us-west-2
+			// https://docs.aws.amazon.com/general/latest/gr/devicefarm.html
+			acctest.PreCheckRegion(t, endpoints.UsWest2RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, devicefarm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTestGridSessionArtifactsDataSourceConfig_basic(sessionARN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "session_arn", sessionARN),
+					resource.TestCheckResourceAttrSet(dataSourceName, "artifacts.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeviceFarmTestGridSessionArtifactsDataSource_type(t *testing.T) {
+	sessionARN := envvar.SkipIfEmpty(t, envVarTestGridSessionARN, envVarTestGridSessionARNMessageError)
+	dataSourceName := "data.aws_devicefarm_test_grid_session_artifacts.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(devicefarm.EndpointsID, t)
+			// Currently, DeviceFarm is only supported in us-west-2
+			// https://docs.aws.amazon.com/general/latest/gr/devicefarm.html
+			acctest.PreCheckRegion(t, endpoints.UsWest2RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, devicefarm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTestGridSessionArtifactsDataSourceConfig_type(sessionARN, devicefarm.TestGridSessionArtifactCategoryLog),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "type", devicefarm.TestGridSessionArtifactCategoryLog),
+					resource.TestCheckResourceAttrSet(dataSourceName, "artifacts.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTestGridSessionArtifactsDataSourceConfig_basic(sessionARN string) string {
+	return fmt.Sprintf(`
+data "aws_devicefarm_test_grid_session_artifacts" "test" {
+  session_arn = %[1]q
+}
+`, sessionARN)
+}
+
+func testAccTestGridSessionArtifactsDataSourceConfig_type(sessionARN, artifactType string) string {
+	return fmt.Sprintf(`
+data "aws_devicefarm_test_grid_session_artifacts" "test" {
+  session_arn = %[1]q
+  type        = %[2]q
+}
+`, sessionARN, artifactType)
+}
@@ -14,6 +14,16 @@ const (
 	replicationTaskStatusStarting  = "starting"
 )
 
+const (
+	replicationTaskAssessmentRunStatusCancelling   = "cancelling"
+	replicationTaskAssessmentRunStatusDeleting     = "deleting"
+	replicationTaskAssessmentRunStatusFailed       = "failed"
+	replicationTaskAssessmentRunStatusPassed       = "passed"
+	replicationTaskAssessmentRunStatusProvisioning = "provisioning"
+	replicationTaskAssessmentRunStatusRunning      = "running"
+	replicationTaskAssessmentRunStatusStarting     = "starting"
+)
+
 const (
 	engineNameAurora                     = "aurora"
 	engineNameAuroraPostgresql           = "aurora-postgresql"
@@ -92,3 +92,52 @@ func FindReplicationTaskByID(ctx context.Context, conn *dms.DatabaseMigrationSer
 
 	return results[0], nil
 }
+
+func FindReplicationTaskAssessmentRunByARN(ctx context.Context, conn *dms.DatabaseMigrationService, arn string) (*dms.ReplicationTaskAssessmentRun, error) {
+	input := &dms.DescribeReplicationTaskAssessmentRunsInput{
+		Filters: []*dms.Filter{
+			{
+				Name:   aws.String("replication-task-assessment-run-arn"),
+				Values: aws.StringSlice([]string{arn}),
+			},
+		},
+	}
+
+	var results []*dms.ReplicationTaskAssessmentRun
+
+	err := conn.DescribeReplicationTaskAssessmentRunsPagesWithContext(ctx, input, func(page *dms.DescribeReplicationTaskAssessmentRunsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, run := range page.ReplicationTaskAssessmentRuns {
+			if run == nil {
+				continue
+			}
+			results = append(results, run)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(results); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return results[0], nil
+}
@@ -0,0 +1,239 @@
+package dms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceReplicationTaskAssessmentRun() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReplicationTaskAssessmentRunCreate,
+		ReadWithoutTimeout:   resourceReplicationTaskAssessmentRunRead,
+		DeleteWithoutTimeout: resourceReplicationTaskAssessmentRunDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assessment_progress": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"individual_assessment_completed_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"individual_assessment_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"assessment_run_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"exclude": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"include_only"},
+			},
+			"include_only": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"exclude"},
+			},
+			"last_failure_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replication_task_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"result_encryption_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(encryptionMode_Values(), false),
+			},
+			"result_kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"result_location_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"result_location_folder": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"service_access_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceReplicationTaskAssessmentRunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn()
+
+	name := d.Get("assessment_run_name").(string)
+	input := &dms.StartReplicationTaskAssessmentRunInput{
+		AssessmentRunName:    aws.String(name),
+		ReplicationTaskArn:   aws.String(d.Get("replication_task_arn").(string)),
+		ResultLocationBucket: aws.String(d.Get("result_location_bucket").(string)),
+		ServiceAccessRoleArn: aws.String(d.Get("service_access_role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("exclude"); ok && v.(*schema.Set).Len() > 0 {
+		input.Exclude = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("include_only"); ok && v.(*schema.Set).Len() > 0 {
+		input.IncludeOnly = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("result_encryption_mode"); ok {
+		input.ResultEncryptionMode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("result_kms_key_arn"); ok {
+		input.ResultKmsKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("result_location_folder"); ok {
+		input.ResultLocationFolder = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Starting DMS Replication Task Assessment Run: %s", input)
+	output, err := conn.StartReplicationTaskAssessmentRunWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting DMS Replication Task Assessment Run (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ReplicationTaskAssessmentRun.ReplicationTaskAssessmentRunArn))
+
+	if _, err := waitReplicationTaskAssessmentRunCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DMS Replication Task Assessment Run (%s) to complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceReplicationTaskAssessmentRunRead(ctx, d, meta)...)
+}
+
+func resourceReplicationTaskAssessmentRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn()
+
+	run, err := FindReplicationTaskAssessmentRunByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DMS Replication Task Assessment Run (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DMS Replication Task Assessment Run (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", run.ReplicationTaskAssessmentRunArn)
+	if err := d.Set("assessment_progress", flattenReplicationTaskAssessmentRunProgress(run.AssessmentProgress)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting assessment_progress: %s", err)
+	}
+	d.Set("assessment_run_name", run.AssessmentRunName)
+	d.Set("last_failure_message", run.LastFailureMessage)
+	d.Set("replication_task_arn", run.ReplicationTaskArn)
+	d.Set("result_encryption_mode", run.ResultEncryptionMode)
+	d.Set("result_kms_key_arn", run.ResultKmsKeyArn)
+	d.Set("result_location_bucket", run.ResultLocationBucket)
+	d.Set("result_location_folder", run.ResultLocationFolder)
+	d.Set("service_access_role_arn", run.ServiceAccessRoleArn)
+	d.Set("status", run.Status)
+
+	return diags
+}
+
+func resourceReplicationTaskAssessmentRunDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn()
+
+	log.Printf("[DEBUG] Deleting DMS Replication Task Assessment Run: %s", d.Id())
+	_, err := conn.DeleteReplicationTaskAssessmentRunWithContext(ctx, &dms.DeleteReplicationTaskAssessmentRunInput{
+		ReplicationTaskAssessmentRunArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DMS Replication Task Assessment Run (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitReplicationTaskAssessmentRunDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DMS Replication Task Assessment Run (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func flattenReplicationTaskAssessmentRunProgress(apiObject *dms.ReplicationTaskAssessmentRunProgress) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"individual_assessment_completed_count": aws.Int64Value(apiObject.IndividualAssessmentCompletedCount),
+		"individual_assessment_count":           aws.Int64Value(apiObject.IndividualAssessmentCount),
+	}
+
+	return []interface{}{tfMap}
+}
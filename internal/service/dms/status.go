@@ -40,3 +40,19 @@ func statusReplicationTask(ctx context.Context, conn *dms.DatabaseMigrationServi
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+func statusReplicationTaskAssessmentRun(ctx context.Context, conn *dms.DatabaseMigrationService, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindReplicationTaskAssessmentRunByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
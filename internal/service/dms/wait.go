@@ -2,10 +2,13 @@ package dms
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -105,3 +108,47 @@ func waitReplicationTaskStopped(ctx context.Context, conn *dms.DatabaseMigration
 
 	return err
 }
+
+func waitReplicationTaskAssessmentRunCompleted(ctx context.Context, conn *dms.DatabaseMigrationService, arn string, timeout time.Duration) (*dms.ReplicationTaskAssessmentRun, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			replicationTaskAssessmentRunStatusStarting,
+			replicationTaskAssessmentRunStatusProvisioning,
+			replicationTaskAssessmentRunStatusRunning,
+		},
+		// A "failed" run completed with at least one failed individual assessment.
+		Target:     []string{replicationTaskAssessmentRunStatusPassed, replicationTaskAssessmentRunStatusFailed},
+		Refresh:    statusReplicationTaskAssessmentRun(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dms.ReplicationTaskAssessmentRun); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.LastFailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicationTaskAssessmentRunDeleted(ctx context.Context, conn *dms.DatabaseMigrationService, arn string, timeout time.Duration) (*dms.ReplicationTaskAssessmentRun, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{replicationTaskAssessmentRunStatusCancelling, replicationTaskAssessmentRunStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusReplicationTaskAssessmentRun(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dms.ReplicationTaskAssessmentRun); ok {
+		return output, err
+	}
+
+	return nil, err
+}
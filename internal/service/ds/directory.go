@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -130,6 +131,10 @@ func ResourceDirectory() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"ou_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"password": {
 				Type:      schema.TypeString,
 				Required:  true,
@@ -364,6 +369,7 @@ func resourceDirectoryRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("edition", dir.Edition)
 	d.Set("enable_sso", dir.SsoEnabled)
 	d.Set("name", dir.Name)
+	d.Set("ou_path", directoryOUPath(dir))
 	if aws.StringValue(dir.Type) == directoryservice.DirectoryTypeAdconnector {
 		d.Set("security_group_id", dir.ConnectSettings.SecurityGroupId)
 	} else {
@@ -459,6 +465,30 @@ func resourceDirectoryDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+// directoryOUPath returns the distinguished name of the organizational unit that
+// AWS creates for delegated administration in an AWS Managed Microsoft AD directory,
+// e.g. "OU=corp,DC=corp,DC=example,DC=com". Other directory types have no such OU.
+func directoryOUPath(apiObject *directoryservice.DirectoryDescription) string {
+	switch aws.StringValue(apiObject.Type) {
+	case directoryservice.DirectoryTypeMicrosoftAd, directoryservice.DirectoryTypeSharedMicrosoftAd:
+	default:
+		return ""
+	}
+
+	name, shortName := aws.StringValue(apiObject.Name), aws.StringValue(apiObject.ShortName)
+
+	if name == "" || shortName == "" {
+		return ""
+	}
+
+	parts := []string{"OU=" + shortName}
+	for _, v := range strings.Split(name, ".") {
+		parts = append(parts, "DC="+v)
+	}
+
+	return strings.Join(parts, ",")
+}
+
 func createAlias(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, alias string) error {
 	input := &directoryservice.CreateAliasInput{
 		Alias:       aws.String(alias),
@@ -87,6 +87,10 @@ func DataSourceDirectory() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"ou_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"radius_settings": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -199,6 +203,7 @@ func dataSourceDirectoryRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("edition", dir.Edition)
 	d.Set("enable_sso", dir.SsoEnabled)
 	d.Set("name", dir.Name)
+	d.Set("ou_path", directoryOUPath(dir))
 	if dir.RadiusSettings != nil {
 		if err := d.Set("radius_settings", []interface{}{flattenRadiusSettings(dir.RadiusSettings)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting radius_settings: %s", err)
@@ -34,6 +34,7 @@ func TestAccDSDirectoryDataSource_simpleAD(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "edition", dataSourceName, "edition"),
 					resource.TestCheckResourceAttrPair(resourceName, "enable_sso", dataSourceName, "enable_sso"),
 					resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "ou_path", dataSourceName, "ou_path"),
 					resource.TestCheckResourceAttr(dataSourceName, "radius_settings.#", "0"),
 					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", dataSourceName, "security_group_id"),
 					resource.TestCheckResourceAttrPair(resourceName, "short_name", dataSourceName, "short_name"),
@@ -74,6 +75,7 @@ func TestAccDSDirectoryDataSource_microsoftAD(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "edition", dataSourceName, "edition"),
 					resource.TestCheckResourceAttrPair(resourceName, "enable_sso", dataSourceName, "enable_sso"),
 					resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "ou_path", dataSourceName, "ou_path"),
 					resource.TestCheckResourceAttr(dataSourceName, "radius_settings.#", "0"),
 					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", dataSourceName, "security_group_id"),
 					resource.TestCheckResourceAttrPair(resourceName, "short_name", dataSourceName, "short_name"),
@@ -123,6 +125,7 @@ func TestAccDSDirectoryDataSource_connector(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "edition", dataSourceName, "edition"),
 					resource.TestCheckResourceAttrPair(resourceName, "enable_sso", dataSourceName, "enable_sso"),
 					resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "ou_path", dataSourceName, "ou_path"),
 					resource.TestCheckResourceAttr(dataSourceName, "radius_settings.#", "0"),
 					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", dataSourceName, "security_group_id"),
 					resource.TestCheckResourceAttrPair(resourceName, "short_name", dataSourceName, "short_name"),
@@ -45,6 +45,7 @@ func TestAccDSDirectory_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "edition", ""),
 					resource.TestCheckResourceAttr(resourceName, "enable_sso", "false"),
 					resource.TestCheckResourceAttr(resourceName, "name", domainName),
+					resource.TestCheckResourceAttr(resourceName, "ou_path", ""),
 					resource.TestCheckResourceAttrSet(resourceName, "security_group_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "short_name"),
 					resource.TestCheckResourceAttr(resourceName, "size", "Small"),
@@ -176,6 +177,7 @@ func TestAccDSDirectory_microsoft(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "edition", "Enterprise"),
 					resource.TestCheckResourceAttr(resourceName, "enable_sso", "false"),
 					resource.TestCheckResourceAttr(resourceName, "name", domainName),
+					resource.TestCheckResourceAttrSet(resourceName, "ou_path"),
 					resource.TestCheckResourceAttrSet(resourceName, "security_group_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "short_name"),
 					resource.TestCheckResourceAttr(resourceName, "size", "Large"),
@@ -224,6 +226,7 @@ func TestAccDSDirectory_microsoftStandard(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "edition", "Standard"),
 					resource.TestCheckResourceAttr(resourceName, "enable_sso", "false"),
 					resource.TestCheckResourceAttr(resourceName, "name", domainName),
+					resource.TestCheckResourceAttrSet(resourceName, "ou_path"),
 					resource.TestCheckResourceAttrSet(resourceName, "security_group_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "short_name"),
 					resource.TestCheckResourceAttr(resourceName, "size", "Small"),
@@ -0,0 +1,134 @@
+package ds
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceDomainControllers() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDomainControllersRead,
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dns_ip_addrs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"domain_controller_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"domain_controllers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_ip_addr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_controller_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"launch_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDomainControllersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DSConn()
+
+	directoryID := d.Get("directory_id").(string)
+	input := &directoryservice.DescribeDomainControllersInput{
+		DirectoryId: aws.String(directoryID),
+	}
+
+	if v, ok := d.GetOk("domain_controller_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.DomainControllerIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	output, err := FindDomainControllers(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Directory Service Directory (%s) domain controllers: %s", directoryID, err)
+	}
+
+	var dnsIPAddrs []string
+	var tfList []interface{}
+
+	for _, v := range output {
+		if aws.StringValue(v.Status) == directoryservice.DomainControllerStatusDeleted {
+			continue
+		}
+
+		dnsIPAddrs = append(dnsIPAddrs, aws.StringValue(v.DnsIpAddr))
+		tfList = append(tfList, flattenDomainController(v))
+	}
+
+	d.SetId(directoryID)
+	d.Set("dns_ip_addrs", dnsIPAddrs)
+	if err := d.Set("domain_controllers", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting domain_controllers: %s", err)
+	}
+
+	return diags
+}
+
+func flattenDomainController(apiObject *directoryservice.DomainController) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"availability_zone":    aws.StringValue(apiObject.AvailabilityZone),
+		"dns_ip_addr":          aws.StringValue(apiObject.DnsIpAddr),
+		"domain_controller_id": aws.StringValue(apiObject.DomainControllerId),
+		"status":               aws.StringValue(apiObject.Status),
+		"subnet_id":            aws.StringValue(apiObject.SubnetId),
+		"vpc_id":               aws.StringValue(apiObject.VpcId),
+	}
+
+	if v := apiObject.LaunchTime; v != nil {
+		tfMap["launch_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
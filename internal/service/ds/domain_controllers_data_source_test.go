@@ -0,0 +1,46 @@
+package ds_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccDSDomainControllersDataSource_basic(t *testing.T) {
+	resourceName := "aws_directory_service_directory.test"
+	dataSourceName := "data.aws_directory_service_domain_controllers.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckDirectoryService(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, directoryservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainControllersDataSourceConfig_basic(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "directory_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "dns_ip_addrs.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "domain_controllers.#", "2"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "domain_controllers.0.availability_zone"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "domain_controllers.0.dns_ip_addr"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "domain_controllers.0.domain_controller_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "domain_controllers.0.status", "Active"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_controllers.0.vpc_id", resourceName, "vpc_settings.0.vpc_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainControllersDataSourceConfig_basic(rName, domain string) string {
+	return acctest.ConfigCompose(testAccDirectoryConfig_microsoftStandard(rName, domain), `
+data "aws_directory_service_domain_controllers" "test" {
+  directory_id = aws_directory_service_directory.test.id
+}
+`)
+}
@@ -228,3 +228,123 @@ func FindSharedDirectory(ctx context.Context, conn *directoryservice.DirectorySe
 
 	return sharedDirectory, nil
 }
+
+func FindTrustByID(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, trustID string) (*directoryservice.Trust, error) {
+	input := &directoryservice.DescribeTrustsInput{
+		TrustIds: aws.StringSlice([]string{trustID}),
+	}
+	if directoryID != "" {
+		input.DirectoryId = aws.String(directoryID)
+	}
+	var output []*directoryservice.Trust
+
+	err := conn.DescribeTrustsPagesWithContext(ctx, input, func(page *directoryservice.DescribeTrustsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Trusts {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeEntityDoesNotExistException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	trust := output[0]
+
+	if state := aws.StringValue(trust.TrustState); state == directoryservice.TrustStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return trust, nil
+}
+
+func FindConditionalForwarder(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, remoteDomainName string) (*directoryservice.ConditionalForwarder, error) {
+	input := &directoryservice.DescribeConditionalForwardersInput{
+		DirectoryId:       aws.String(directoryID),
+		RemoteDomainNames: aws.StringSlice([]string{remoteDomainName}),
+	}
+
+	output, err := conn.DescribeConditionalForwardersWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeEntityDoesNotExistException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ConditionalForwarders) == 0 || output.ConditionalForwarders[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ConditionalForwarders); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ConditionalForwarders[0], nil
+}
+
+func FindSettings(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string) ([]*directoryservice.SettingEntry, error) {
+	input := &directoryservice.DescribeSettingsInput{
+		DirectoryId: aws.String(directoryID),
+	}
+	var output []*directoryservice.SettingEntry
+
+	for {
+		page, err := conn.DescribeSettingsWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeDirectoryDoesNotExistException) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.SettingEntries {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
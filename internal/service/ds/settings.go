@@ -0,0 +1,192 @@
+package ds
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSettingsCreate,
+		ReadWithoutTimeout:   resourceSettingsRead,
+		UpdateWithoutTimeout: resourceSettingsUpdate,
+		DeleteWithoutTimeout: resourceSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"setting": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn()
+
+	directoryID := d.Get("directory_id").(string)
+	settings := expandSettings(d.Get("setting").(*schema.Set).List())
+
+	if err := updateSettings(ctx, conn, directoryID, settings, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("creating Directory Service Directory (%s) settings: %s", directoryID, err)
+	}
+
+	d.SetId(directoryID)
+
+	return resourceSettingsRead(ctx, d, meta)
+}
+
+func resourceSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn()
+
+	output, err := FindSettings(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Directory Service Directory (%s) settings not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Directory Service Directory (%s) settings: %s", d.Id(), err)
+	}
+
+	// Only the settings managed by this resource are tracked, unless none are (e.g. on import),
+	// in which case every setting that has been changed from its default is.
+	configured := make(map[string]bool)
+	for _, v := range expandSettings(d.Get("setting").(*schema.Set).List()) {
+		configured[aws.StringValue(v.Name)] = true
+	}
+
+	var tfList []interface{}
+	for _, v := range output {
+		name := aws.StringValue(v.Name)
+
+		if len(configured) > 0 && !configured[name] {
+			continue
+		}
+
+		if len(configured) == 0 && aws.StringValue(v.RequestStatus) == directoryservice.DirectoryConfigurationStatusDefault {
+			continue
+		}
+
+		value := aws.StringValue(v.AppliedValue)
+		if requested := aws.StringValue(v.RequestedValue); requested != "" {
+			value = requested
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":  name,
+			"value": value,
+		})
+	}
+
+	d.Set("directory_id", d.Id())
+	if err := d.Set("setting", tfList); err != nil {
+		return diag.Errorf("setting setting: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn()
+
+	if d.HasChange("setting") {
+		o, n := d.GetChange("setting")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Settings removed from the configuration are left at their current values.
+		if settings := expandSettings(ns.Difference(os).List()); len(settings) > 0 {
+			if err := updateSettings(ctx, conn, d.Id(), settings, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("updating Directory Service Directory (%s) settings: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceSettingsRead(ctx, d, meta)
+}
+
+func resourceSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Directory Service Directory (%s) settings cannot be reset to their defaults, removing from state only", d.Id())
+
+	return nil
+}
+
+func updateSettings(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string, settings []*directoryservice.Setting, timeout time.Duration) error {
+	input := &directoryservice.UpdateSettingsInput{
+		DirectoryId: aws.String(directoryID),
+		Settings:    settings,
+	}
+
+	log.Printf("[DEBUG] Updating Directory Service Directory settings: %s", input)
+	_, err := conn.UpdateSettingsWithContext(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(settings))
+	for _, v := range settings {
+		names = append(names, aws.StringValue(v.Name))
+	}
+
+	return waitSettingsUpdated(ctx, conn, directoryID, names, timeout)
+}
+
+func expandSettings(tfList []interface{}) []*directoryservice.Setting {
+	var apiObjects []*directoryservice.Setting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &directoryservice.Setting{
+			Name:  aws.String(tfMap["name"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
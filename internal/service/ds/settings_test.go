@@ -0,0 +1,122 @@
+package ds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfds "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
+)
+
+func TestAccDSSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_directory_service_settings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckDirectoryService(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, directoryservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingsConfig_basic(rName, domainName, "Disable"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSettingValue(ctx, resourceName, "TLS_1_0", "Disable"),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", "aws_directory_service_directory.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "setting.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "setting.*", map[string]string{
+						"name":  "TLS_1_0",
+						"value": "Disable",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSettingsConfig_basic(rName, domainName, "Enable"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSettingValue(ctx, resourceName, "TLS_1_0", "Enable"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "setting.*", map[string]string{
+						"name":  "TLS_1_0",
+						"value": "Enable",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSettingValue(ctx context.Context, n, name, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Directory Service Settings ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DSConn()
+
+		output, err := tfds.FindSettings(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output {
+			if aws.StringValue(v.Name) != name {
+				continue
+			}
+
+			if got := aws.StringValue(v.AppliedValue); got != value {
+				return fmt.Errorf("Directory Service Directory (%s) setting %s: expected %q, got %q", rs.Primary.ID, name, value, got)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("Directory Service Directory (%s) setting %s not found", rs.Primary.ID, name)
+	}
+}
+
+func testAccSettingsConfig_basic(rName, domain, value string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_directory_service_directory" "test" {
+  name     = %[1]q
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+  edition  = "Standard"
+
+  vpc_settings {
+    vpc_id     = aws_vpc.test.id
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+
+resource "aws_directory_service_settings" "test" {
+  directory_id = aws_directory_service_directory.test.id
+
+  setting {
+    name  = "TLS_1_0"
+    value = %[2]q
+  }
+}
+`, domain, value))
+}
@@ -33,6 +33,7 @@ func ResourceSharedDirectory() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
@@ -107,9 +108,12 @@ func resourceSharedDirectoryCreate(ctx context.Context, d *schema.ResourceData,
 
 	log.Printf("[DEBUG] Shared Directory created: %s", out)
 	d.SetId(sharedDirectoryID(dirId, aws.StringValue(out.SharedDirectoryId)))
-	d.Set("shared_directory_id", out.SharedDirectoryId)
 
-	return nil
+	if _, err := waitSharedDirectoryCreated(ctx, conn, dirId, aws.StringValue(out.SharedDirectoryId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.DS, create.ErrActionWaitingForCreation, ResNameSharedDirectory, d.Id(), err)
+	}
+
+	return resourceSharedDirectoryRead(ctx, d, meta)
 }
 
 func resourceSharedDirectoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
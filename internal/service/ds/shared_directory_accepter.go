@@ -37,14 +37,27 @@ func ResourceSharedDirectoryAccepter() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"dns_ip_addresses": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"method": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"notes": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"ou_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"owner_account_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -65,8 +78,19 @@ func ResourceSharedDirectoryAccepter() *schema.Resource {
 func resourceSharedDirectoryAccepterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).DSConn()
 
+	sharedDirectoryID := d.Get("shared_directory_id").(string)
+
+	// Directories shared using AWS Organizations are shared without a handshake and cannot be accepted.
+	if dir, err := FindDirectoryByID(ctx, conn, sharedDirectoryID); err == nil && aws.StringValue(dir.ShareStatus) == directoryservice.ShareStatusShared {
+		log.Printf("[DEBUG] Shared directory (%s) already accepted", sharedDirectoryID)
+		d.SetId(sharedDirectoryID)
+		d.Set("notes", dir.ShareNotes)
+
+		return resourceSharedDirectoryAccepterRead(ctx, d, meta)
+	}
+
 	input := directoryservice.AcceptSharedDirectoryInput{
-		SharedDirectoryId: aws.String(d.Get("shared_directory_id").(string)),
+		SharedDirectoryId: aws.String(sharedDirectoryID),
 	}
 
 	log.Printf("[DEBUG] Accepting shared directory: %s", input)
@@ -74,14 +98,14 @@ func resourceSharedDirectoryAccepterCreate(ctx context.Context, d *schema.Resour
 	output, err := conn.AcceptSharedDirectoryWithContext(ctx, &input)
 
 	if err != nil {
-		return create.DiagError(names.DS, create.ErrActionCreating, ResNameSharedDirectoryAccepter, d.Get("shared_directory_id").(string), err)
+		return create.DiagError(names.DS, create.ErrActionCreating, ResNameSharedDirectoryAccepter, sharedDirectoryID, err)
 	}
 
 	if output == nil || output.SharedDirectory == nil {
-		return create.DiagError(names.DS, create.ErrActionCreating, ResNameSharedDirectoryAccepter, d.Get("shared_directory_id").(string), errors.New("empty output"))
+		return create.DiagError(names.DS, create.ErrActionCreating, ResNameSharedDirectoryAccepter, sharedDirectoryID, errors.New("empty output"))
 	}
 
-	d.SetId(d.Get("shared_directory_id").(string))
+	d.SetId(sharedDirectoryID)
 
 	d.Set("notes", output.SharedDirectory.ShareNotes) // only available in response to create
 
@@ -99,13 +123,28 @@ func resourceSharedDirectoryAccepterRead(ctx context.Context, d *schema.Resource
 
 	dir, err := FindDirectoryByID(ctx, conn, d.Id())
 
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.DS, create.ErrActionReading, ResNameSharedDirectoryAccepter, d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	if err != nil {
 		return create.DiagError(names.DS, create.ErrActionReading, ResNameSharedDirectoryAccepter, d.Id(), err)
 	}
 
+	if dir.OwnerDirectoryDescription != nil {
+		d.Set("dns_ip_addresses", aws.StringValueSlice(dir.OwnerDirectoryDescription.DnsIpAddrs))
+		d.Set("owner_account_id", dir.OwnerDirectoryDescription.AccountId)
+		d.Set("owner_directory_id", dir.OwnerDirectoryDescription.DirectoryId)
+	} else {
+		d.Set("dns_ip_addresses", aws.StringValueSlice(dir.DnsIpAddrs))
+		d.Set("owner_account_id", nil)
+		d.Set("owner_directory_id", nil)
+	}
 	d.Set("method", dir.ShareMethod)
-	d.Set("owner_account_id", dir.OwnerDirectoryDescription.AccountId)
-	d.Set("owner_directory_id", dir.OwnerDirectoryDescription.DirectoryId)
+	d.Set("name", dir.Name)
+	d.Set("ou_path", directoryOUPath(dir))
 	d.Set("shared_directory_id", dir.DirectoryId)
 
 	return nil
@@ -35,8 +35,11 @@ func TestAccDSSharedDirectoryAccepter_basic(t *testing.T) {
 				Config: testAccSharedDirectoryAccepterConfig_basic(rName, domainName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckSharedDirectoryAccepterExists(ctx, resourceName),
+					acctest.CheckResourceAttrGreaterThanValue(resourceName, "dns_ip_addresses.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "method", directoryservice.ShareMethodHandshake),
+					resource.TestCheckResourceAttr(resourceName, "name", domainName),
 					resource.TestCheckResourceAttr(resourceName, "notes", "There were hints and allegations"),
+					resource.TestCheckResourceAttrSet(resourceName, "ou_path"),
 					resource.TestCheckResourceAttrPair(resourceName, "owner_account_id", "data.aws_caller_identity.current", "account_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "owner_directory_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "shared_directory_id"),
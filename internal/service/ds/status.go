@@ -104,3 +104,57 @@ func statusSharedDirectory(ctx context.Context, conn *directoryservice.Directory
 		return output, aws.StringValue(output.ShareStatus), nil
 	}
 }
+
+func statusTrust(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, trustID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTrustByID(ctx, conn, directoryID, trustID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.TrustState), nil
+	}
+}
+
+// statusSettings returns the aggregate request status of the named directory settings.
+// The status is Failed if any setting failed to update, Updating if any setting has an update in progress, and Updated otherwise.
+func statusSettings(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string, names []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSettings(ctx, conn, directoryID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		wanted := make(map[string]bool, len(names))
+		for _, name := range names {
+			wanted[name] = true
+		}
+
+		status := directoryservice.DirectoryConfigurationStatusUpdated
+
+		for _, v := range output {
+			if !wanted[aws.StringValue(v.Name)] {
+				continue
+			}
+
+			switch aws.StringValue(v.RequestStatus) {
+			case directoryservice.DirectoryConfigurationStatusFailed:
+				return v, directoryservice.DirectoryConfigurationStatusFailed, nil
+			case directoryservice.DirectoryConfigurationStatusRequested, directoryservice.DirectoryConfigurationStatusUpdating:
+				status = directoryservice.DirectoryConfigurationStatusUpdating
+			}
+		}
+
+		return output, status, nil
+	}
+}
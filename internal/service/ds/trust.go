@@ -0,0 +1,254 @@
+package ds
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceTrust() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTrustCreate,
+		ReadWithoutTimeout:   resourceTrustRead,
+		UpdateWithoutTimeout: resourceTrustUpdate,
+		DeleteWithoutTimeout: resourceTrustDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"conditional_forwarder_ip_addrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+			},
+			"created_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_associated_conditional_forwarder": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"last_updated_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remote_domain_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([a-zA-Z0-9]+[\.-])+([a-zA-Z0-9])+[.]?$`), "invalid value, see the RemoteDomainName attribute documentation: https://docs.aws.amazon.com/directoryservice/latest/devguide/API_Trust.html"),
+			},
+			"selective_auth": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(directoryservice.SelectiveAuth_Values(), false),
+			},
+			"state_last_updated_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trust_direction": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(directoryservice.TrustDirection_Values(), false),
+			},
+			"trust_password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"trust_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trust_state_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trust_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      directoryservice.TrustTypeForest,
+				ValidateFunc: validation.StringInSlice(directoryservice.TrustType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceTrustCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn()
+
+	directoryID := d.Get("directory_id").(string)
+	remoteDomainName := d.Get("remote_domain_name").(string)
+	input := &directoryservice.CreateTrustInput{
+		DirectoryId:      aws.String(directoryID),
+		RemoteDomainName: aws.String(remoteDomainName),
+		TrustDirection:   aws.String(d.Get("trust_direction").(string)),
+		TrustPassword:    aws.String(d.Get("trust_password").(string)),
+		TrustType:        aws.String(d.Get("trust_type").(string)),
+	}
+
+	if v, ok := d.GetOk("conditional_forwarder_ip_addrs"); ok && v.(*schema.Set).Len() > 0 {
+		input.ConditionalForwarderIpAddrs = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("selective_auth"); ok {
+		input.SelectiveAuth = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Directory Service Trust: %s", input)
+	output, err := conn.CreateTrustWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Directory Service Directory (%s) Trust (%s): %s", directoryID, remoteDomainName, err)
+	}
+
+	d.SetId(aws.StringValue(output.TrustId))
+
+	if _, err := waitTrustCreated(ctx, conn, directoryID, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Directory Service Trust (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceTrustRead(ctx, d, meta)
+}
+
+func resourceTrustRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn()
+
+	trust, err := FindTrustByID(ctx, conn, d.Get("directory_id").(string), d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Directory Service Trust (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Directory Service Trust (%s): %s", d.Id(), err)
+	}
+
+	d.Set("created_date_time", aws.TimeValue(trust.CreatedDateTime).Format(time.RFC3339))
+	d.Set("directory_id", trust.DirectoryId)
+	d.Set("last_updated_date_time", aws.TimeValue(trust.LastUpdatedDateTime).Format(time.RFC3339))
+	d.Set("remote_domain_name", trust.RemoteDomainName)
+	d.Set("selective_auth", trust.SelectiveAuth)
+	d.Set("state_last_updated_date_time", aws.TimeValue(trust.StateLastUpdatedDateTime).Format(time.RFC3339))
+	d.Set("trust_direction", trust.TrustDirection)
+	d.Set("trust_state", trust.TrustState)
+	d.Set("trust_state_reason", trust.TrustStateReason)
+	d.Set("trust_type", trust.TrustType)
+
+	forwarder, err := FindConditionalForwarder(ctx, conn, aws.StringValue(trust.DirectoryId), aws.StringValue(trust.RemoteDomainName))
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("conditional_forwarder_ip_addrs", nil)
+	case err != nil:
+		return diag.Errorf("reading Directory Service Trust (%s) conditional forwarder: %s", d.Id(), err)
+	default:
+		d.Set("conditional_forwarder_ip_addrs", aws.StringValueSlice(forwarder.DnsIpAddrs))
+	}
+
+	return nil
+}
+
+func resourceTrustUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn()
+
+	directoryID := d.Get("directory_id").(string)
+
+	if d.HasChange("selective_auth") {
+		input := &directoryservice.UpdateTrustInput{
+			SelectiveAuth: aws.String(d.Get("selective_auth").(string)),
+			TrustId:       aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Directory Service Trust: %s", input)
+		_, err := conn.UpdateTrustWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Directory Service Trust (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitTrustUpdated(ctx, conn, directoryID, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for Directory Service Trust (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("conditional_forwarder_ip_addrs") {
+		input := &directoryservice.UpdateConditionalForwarderInput{
+			DirectoryId:      aws.String(directoryID),
+			DnsIpAddrs:       flex.ExpandStringSet(d.Get("conditional_forwarder_ip_addrs").(*schema.Set)),
+			RemoteDomainName: aws.String(d.Get("remote_domain_name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Directory Service Trust conditional forwarder: %s", input)
+		_, err := conn.UpdateConditionalForwarderWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Directory Service Trust (%s) conditional forwarder: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTrustRead(ctx, d, meta)
+}
+
+func resourceTrustDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn()
+
+	log.Printf("[DEBUG] Deleting Directory Service Trust: %s", d.Id())
+	_, err := conn.DeleteTrustWithContext(ctx, &directoryservice.DeleteTrustInput{
+		DeleteAssociatedConditionalForwarder: aws.Bool(d.Get("delete_associated_conditional_forwarder").(bool)),
+		TrustId:                              aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeEntityDoesNotExistException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Directory Service Trust (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitTrustDeleted(ctx, conn, d.Get("directory_id").(string), d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Directory Service Trust (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
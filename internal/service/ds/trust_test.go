@@ -0,0 +1,219 @@
+package ds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfds "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDSTrust_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v directoryservice.Trust
+	resourceName := "aws_directory_service_trust.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+	domainNameOther := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckDirectoryService(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, directoryservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustConfig_basic(rName, domainName, domainNameOther, "Disabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "conditional_forwarder_ip_addrs.#", "2"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "created_date_time"),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", "aws_directory_service_directory.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "remote_domain_name", domainNameOther),
+					resource.TestCheckResourceAttr(resourceName, "selective_auth", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, "trust_direction", "Two-Way"),
+					resource.TestCheckResourceAttr(resourceName, "trust_state", "Verified"),
+					resource.TestCheckResourceAttr(resourceName, "trust_type", "Forest"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"delete_associated_conditional_forwarder",
+					"trust_password",
+				},
+			},
+			{
+				Config: testAccTrustConfig_basic(rName, domainName, domainNameOther, "Enabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "selective_auth", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "trust_state", "Verified"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDSTrust_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v directoryservice.Trust
+	resourceName := "aws_directory_service_trust.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+	domainNameOther := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckDirectoryService(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, directoryservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustConfig_basic(rName, domainName, domainNameOther, "Disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfds.ResourceTrust(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTrustDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DSConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_directory_service_trust" {
+				continue
+			}
+
+			_, err := tfds.FindTrustByID(ctx, conn, rs.Primary.Attributes["directory_id"], rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Directory Service Trust %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTrustExists(ctx context.Context, n string, v *directoryservice.Trust) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Directory Service Trust ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DSConn()
+
+		output, err := tfds.FindTrustByID(ctx, conn, rs.Primary.Attributes["directory_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTrustConfig_basic(rName, domain, domainOther, selectiveAuth string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_directory_service_directory" "test" {
+  name     = %[1]q
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+  edition  = "Standard"
+
+  vpc_settings {
+    vpc_id     = aws_vpc.test.id
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+
+resource "aws_directory_service_directory" "other" {
+  name     = %[2]q
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+  edition  = "Standard"
+
+  vpc_settings {
+    vpc_id     = aws_vpc.test.id
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+
+resource "aws_security_group_rule" "test" {
+  type                     = "egress"
+  from_port                = 0
+  to_port                  = 0
+  protocol                 = "-1"
+  security_group_id        = aws_directory_service_directory.test.security_group_id
+  source_security_group_id = aws_directory_service_directory.other.security_group_id
+}
+
+resource "aws_security_group_rule" "other" {
+  type                     = "egress"
+  from_port                = 0
+  to_port                  = 0
+  protocol                 = "-1"
+  security_group_id        = aws_directory_service_directory.other.security_group_id
+  source_security_group_id = aws_directory_service_directory.test.security_group_id
+}
+
+resource "aws_directory_service_trust" "test" {
+  directory_id = aws_directory_service_directory.test.id
+
+  remote_domain_name = aws_directory_service_directory.other.name
+  trust_direction    = "Two-Way"
+  trust_password     = "Some0therPassword"
+  selective_auth     = %[3]q
+
+  conditional_forwarder_ip_addrs = aws_directory_service_directory.other.dns_ip_addresses
+
+  depends_on = [aws_security_group_rule.test, aws_directory_service_trust.other]
+}
+
+resource "aws_directory_service_trust" "other" {
+  directory_id = aws_directory_service_directory.other.id
+
+  remote_domain_name = aws_directory_service_directory.test.name
+  trust_direction    = "Two-Way"
+  trust_password     = "Some0therPassword"
+
+  conditional_forwarder_ip_addrs = aws_directory_service_directory.test.dns_ip_addresses
+
+  depends_on = [aws_security_group_rule.other]
+}
+`, domain, domainOther, selectiveAuth))
+}
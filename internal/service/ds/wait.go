@@ -164,7 +164,24 @@ func waitSharedDirectoryDeleted(ctx context.Context, conn *directoryservice.Dire
 	return nil, err
 }
 
-func waitDirectoryShared(ctx context.Context, conn *directoryservice.DirectoryService, id string, timeout time.Duration) (*directoryservice.SharedDirectory, error) {
+func waitSharedDirectoryCreated(ctx context.Context, conn *directoryservice.DirectoryService, ownerDirectoryID, sharedDirectoryID string, timeout time.Duration) (*directoryservice.SharedDirectory, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{directoryservice.ShareStatusSharing},
+		Target:  []string{directoryservice.ShareStatusPendingAcceptance, directoryservice.ShareStatusShared},
+		Refresh: statusSharedDirectory(ctx, conn, ownerDirectoryID, sharedDirectoryID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directoryservice.SharedDirectory); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDirectoryShared(ctx context.Context, conn *directoryservice.DirectoryService, id string, timeout time.Duration) (*directoryservice.DirectoryDescription, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:                   []string{directoryservice.ShareStatusPendingAcceptance, directoryservice.ShareStatusSharing},
 		Target:                    []string{directoryservice.ShareStatusShared},
@@ -175,9 +192,85 @@ func waitDirectoryShared(ctx context.Context, conn *directoryservice.DirectorySe
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*directoryservice.SharedDirectory); ok {
+	if output, ok := outputRaw.(*directoryservice.DirectoryDescription); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StageReason)))
+
 		return output, err
 	}
 
 	return nil, err
 }
+
+func waitTrustCreated(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, trustID string, timeout time.Duration) (*directoryservice.Trust, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{directoryservice.TrustStateCreating, directoryservice.TrustStateCreated, directoryservice.TrustStateVerifying},
+		Target:  []string{directoryservice.TrustStateVerified},
+		Refresh: statusTrust(ctx, conn, directoryID, trustID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directoryservice.Trust); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.TrustStateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTrustUpdated(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, trustID string, timeout time.Duration) (*directoryservice.Trust, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{directoryservice.TrustStateUpdating, directoryservice.TrustStateUpdated, directoryservice.TrustStateVerifying},
+		Target:  []string{directoryservice.TrustStateVerified},
+		Refresh: statusTrust(ctx, conn, directoryID, trustID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directoryservice.Trust); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.TrustStateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTrustDeleted(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, trustID string, timeout time.Duration) (*directoryservice.Trust, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{directoryservice.TrustStateDeleting},
+		Target:  []string{},
+		Refresh: statusTrust(ctx, conn, directoryID, trustID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directoryservice.Trust); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.TrustStateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSettingsUpdated(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string, names []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{directoryservice.DirectoryConfigurationStatusUpdating},
+		Target:  []string{directoryservice.DirectoryConfigurationStatusUpdated},
+		Refresh: statusSettings(ctx, conn, directoryID, names),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directoryservice.SettingEntry); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.RequestStatusMessage)))
+	}
+
+	return err
+}
@@ -86,6 +86,14 @@ const (
 	CustomerGatewayStatePending   = "pending"
 )
 
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_LocalGatewayRouteTable.html.
+const (
+	localGatewayRouteTableStateAvailable = "available"
+	localGatewayRouteTableStateDeleted   = "deleted"
+	localGatewayRouteTableStateDeleting  = "deleting"
+	localGatewayRouteTableStatePending   = "pending"
+)
+
 const (
 	managedPrefixListAddressFamilyIPv4 = "IPv4"
 	managedPrefixListAddressFamilyIPv6 = "IPv6"
@@ -1278,6 +1278,41 @@ func FindLocalGatewayRouteTable(ctx context.Context, conn *ec2.EC2, input *ec2.D
 	return output[0], nil
 }
 
+func FindLocalGatewayRouteTableByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.LocalGatewayRouteTable, error) {
+	input := &ec2.DescribeLocalGatewayRouteTablesInput{
+		LocalGatewayRouteTableIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindLocalGatewayRouteTable(ctx, conn, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidLocalGatewayRouteTableIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == localGatewayRouteTableStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.LocalGatewayRouteTableId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindLocalGatewayVirtualInterfaceGroups(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeLocalGatewayVirtualInterfaceGroupsInput) ([]*ec2.LocalGatewayVirtualInterfaceGroup, error) {
 	var output []*ec2.LocalGatewayVirtualInterfaceGroup
 
@@ -6587,6 +6622,79 @@ func FindSnapshotTierStatusBySnapshotID(ctx context.Context, conn *ec2.EC2, id s
 	return output, nil
 }
 
+func FindFastSnapshotRestores(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeFastSnapshotRestoresInput) ([]*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	var output []*ec2.DescribeFastSnapshotRestoreSuccessItem
+
+	err := conn.DescribeFastSnapshotRestoresPagesWithContext(ctx, input, func(page *ec2.DescribeFastSnapshotRestoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FastSnapshotRestores {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindFastSnapshotRestore(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeFastSnapshotRestoresInput) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	output, err := FindFastSnapshotRestores(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindFastSnapshotRestoreByTwoPartKey(ctx context.Context, conn *ec2.EC2, availabilityZone, snapshotID string) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	input := &ec2.DescribeFastSnapshotRestoresInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"availability-zone": availabilityZone,
+			"snapshot-id":       snapshotID,
+		}),
+	}
+
+	output, err := FindFastSnapshotRestore(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == ec2.FastSnapshotRestoreStateCodeDisabled {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.AvailabilityZone) != availabilityZone || aws.StringValue(output.SnapshotId) != snapshotID {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindNetworkPerformanceMetricSubscriptions(ctx context.Context, conn *ec2_sdkv2.Client, input *ec2_sdkv2.DescribeAwsNetworkPerformanceMetricSubscriptionsInput) ([]types.Subscription, error) {
 	var output []types.Subscription
 	paginator := ec2_sdkv2.NewDescribeAwsNetworkPerformanceMetricSubscriptionsPaginator(conn, input, func(o *ec2_sdkv2.DescribeAwsNetworkPerformanceMetricSubscriptionsPaginatorOptions) {
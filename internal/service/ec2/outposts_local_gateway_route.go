@@ -27,6 +27,7 @@ func ResourceLocalGatewayRoute() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLocalGatewayRouteCreate,
 		ReadWithoutTimeout:   resourceLocalGatewayRouteRead,
+		UpdateWithoutTimeout: resourceLocalGatewayRouteUpdate,
 		DeleteWithoutTimeout: resourceLocalGatewayRouteDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -45,9 +46,14 @@ func ResourceLocalGatewayRoute() *schema.Resource {
 				ForceNew: true,
 			},
 			"local_gateway_virtual_interface_group_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"local_gateway_virtual_interface_group_id", "network_interface_id"},
+			},
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"local_gateway_virtual_interface_group_id", "network_interface_id"},
 			},
 		},
 	}
@@ -61,9 +67,16 @@ func resourceLocalGatewayRouteCreate(ctx context.Context, d *schema.ResourceData
 	localGatewayRouteTableID := d.Get("local_gateway_route_table_id").(string)
 
 	input := &ec2.CreateLocalGatewayRouteInput{
-		DestinationCidrBlock:                aws.String(destination),
-		LocalGatewayRouteTableId:            aws.String(localGatewayRouteTableID),
-		LocalGatewayVirtualInterfaceGroupId: aws.String(d.Get("local_gateway_virtual_interface_group_id").(string)),
+		DestinationCidrBlock:     aws.String(destination),
+		LocalGatewayRouteTableId: aws.String(localGatewayRouteTableID),
+	}
+
+	if v, ok := d.GetOk("local_gateway_virtual_interface_group_id"); ok {
+		input.LocalGatewayVirtualInterfaceGroupId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("network_interface_id"); ok {
+		input.NetworkInterfaceId = aws.String(v.(string))
 	}
 
 	_, err := conn.CreateLocalGatewayRouteWithContext(ctx, input)
@@ -138,10 +151,43 @@ func resourceLocalGatewayRouteRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("destination_cidr_block", localGatewayRoute.DestinationCidrBlock)
 	d.Set("local_gateway_virtual_interface_group_id", localGatewayRoute.LocalGatewayVirtualInterfaceGroupId)
 	d.Set("local_gateway_route_table_id", localGatewayRoute.LocalGatewayRouteTableId)
+	d.Set("network_interface_id", localGatewayRoute.NetworkInterfaceId)
 
 	return diags
 }
 
+func resourceLocalGatewayRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	localGatewayRouteTableID, destination, err := DecodeLocalGatewayRouteID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating EC2 Local Gateway Route (%s): %s", d.Id(), err)
+	}
+
+	input := &ec2.ModifyLocalGatewayRouteInput{
+		DestinationCidrBlock:     aws.String(destination),
+		LocalGatewayRouteTableId: aws.String(localGatewayRouteTableID),
+	}
+
+	if v, ok := d.GetOk("local_gateway_virtual_interface_group_id"); ok {
+		input.LocalGatewayVirtualInterfaceGroupId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("network_interface_id"); ok {
+		input.NetworkInterfaceId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating EC2 Local Gateway Route: %s", input)
+	_, err = conn.ModifyLocalGatewayRouteWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating EC2 Local Gateway Route (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceLocalGatewayRouteRead(ctx, d, meta)...)
+}
+
 func resourceLocalGatewayRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
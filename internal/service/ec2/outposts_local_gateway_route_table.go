@@ -0,0 +1,181 @@
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLocalGatewayRouteTable() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLocalGatewayRouteTableCreate,
+		ReadWithoutTimeout:   resourceLocalGatewayRouteTableRead,
+		UpdateWithoutTimeout: resourceLocalGatewayRouteTableUpdate,
+		DeleteWithoutTimeout: resourceLocalGatewayRouteTableDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"local_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.LocalGatewayRouteTableMode_Values(), false),
+			},
+			"outpost_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceLocalGatewayRouteTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateLocalGatewayRouteTableInput{
+		LocalGatewayId:    aws.String(d.Get("local_gateway_id").(string)),
+		TagSpecifications: tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeLocalGatewayRouteTable),
+	}
+
+	if v, ok := d.GetOk("mode"); ok {
+		input.Mode = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Local Gateway Route Table: %s", input)
+	output, err := conn.CreateLocalGatewayRouteTableWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Local Gateway Route Table: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.LocalGatewayRouteTable.LocalGatewayRouteTableId))
+
+	if _, err := WaitLocalGatewayRouteTableCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Local Gateway Route Table (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceLocalGatewayRouteTableRead(ctx, d, meta)...)
+}
+
+func resourceLocalGatewayRouteTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	routeTable, err := FindLocalGatewayRouteTableByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Local Gateway Route Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Local Gateway Route Table (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", routeTable.LocalGatewayRouteTableArn)
+	d.Set("local_gateway_id", routeTable.LocalGatewayId)
+	d.Set("mode", routeTable.Mode)
+	d.Set("outpost_arn", routeTable.OutpostArn)
+	d.Set("owner_id", routeTable.OwnerId)
+	d.Set("state", routeTable.State)
+
+	tags := KeyValueTags(routeTable.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceLocalGatewayRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Local Gateway Route Table (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceLocalGatewayRouteTableRead(ctx, d, meta)...)
+}
+
+func resourceLocalGatewayRouteTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	log.Printf("[DEBUG] Deleting EC2 Local Gateway Route Table: %s", d.Id())
+	_, err := conn.DeleteLocalGatewayRouteTableWithContext(ctx, &ec2.DeleteLocalGatewayRouteTableInput{
+		LocalGatewayRouteTableId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidLocalGatewayRouteTableIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Local Gateway Route Table (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitLocalGatewayRouteTableDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Local Gateway Route Table (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -35,6 +35,11 @@ func DataSourceLocalGatewayRouteTable() *schema.Resource {
 				Computed: true,
 			},
 
+			"mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"outpost_arn": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -102,6 +107,7 @@ func dataSourceLocalGatewayRouteTableRead(ctx context.Context, d *schema.Resourc
 	d.SetId(aws.StringValue(localgatewayroutetable.LocalGatewayRouteTableId))
 	d.Set("local_gateway_id", localgatewayroutetable.LocalGatewayId)
 	d.Set("local_gateway_route_table_id", localgatewayroutetable.LocalGatewayRouteTableId)
+	d.Set("mode", localgatewayroutetable.Mode)
 	d.Set("outpost_arn", localgatewayroutetable.OutpostArn)
 	d.Set("state", localgatewayroutetable.State)
 
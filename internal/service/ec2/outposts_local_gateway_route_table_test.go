@@ -0,0 +1,249 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2OutpostsLocalGatewayRouteTable_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_local_gateway_route_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLocalGatewayRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostsLocalGatewayRouteTableConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocalGatewayRouteTableExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`local-gateway-route-table/lgw-rtb-.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "local_gateway_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "mode"),
+					resource.TestCheckResourceAttrSet(resourceName, "outpost_arn"),
+					acctest.CheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "available"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2OutpostsLocalGatewayRouteTable_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_local_gateway_route_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLocalGatewayRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostsLocalGatewayRouteTableConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocalGatewayRouteTableExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceLocalGatewayRouteTable(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2OutpostsLocalGatewayRouteTable_coipMode(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_local_gateway_route_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLocalGatewayRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostsLocalGatewayRouteTableConfig_mode(rName, ec2.LocalGatewayRouteTableModeCoip),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocalGatewayRouteTableExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "mode", ec2.LocalGatewayRouteTableModeCoip),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2OutpostsLocalGatewayRouteTable_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_local_gateway_route_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLocalGatewayRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostsLocalGatewayRouteTableConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocalGatewayRouteTableExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOutpostsLocalGatewayRouteTableConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocalGatewayRouteTableExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccOutpostsLocalGatewayRouteTableConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocalGatewayRouteTableExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLocalGatewayRouteTableExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Local Gateway Route Table ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		_, err := tfec2.FindLocalGatewayRouteTableByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckLocalGatewayRouteTableDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_local_gateway_route_table" {
+				continue
+			}
+
+			_, err := tfec2.FindLocalGatewayRouteTableByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Local Gateway Route Table %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccOutpostsLocalGatewayRouteTableConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ec2_local_gateways" "test" {}
+
+resource "aws_ec2_local_gateway_route_table" "test" {
+  local_gateway_id = tolist(data.aws_ec2_local_gateways.test.ids)[0]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccOutpostsLocalGatewayRouteTableConfig_mode(rName, mode string) string {
+	return fmt.Sprintf(`
+data "aws_ec2_local_gateways" "test" {}
+
+resource "aws_ec2_local_gateway_route_table" "test" {
+  local_gateway_id = tolist(data.aws_ec2_local_gateways.test.ids)[0]
+  mode             = %[2]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, mode)
+}
+
+func testAccOutpostsLocalGatewayRouteTableConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_ec2_local_gateways" "test" {}
+
+resource "aws_ec2_local_gateway_route_table" "test" {
+  local_gateway_id = tolist(data.aws_ec2_local_gateways.test.ids)[0]
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccOutpostsLocalGatewayRouteTableConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_ec2_local_gateways" "test" {}
+
+resource "aws_ec2_local_gateway_route_table" "test" {
+  local_gateway_id = tolist(data.aws_ec2_local_gateways.test.ids)[0]
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -1022,6 +1022,22 @@ func StatusCustomerGatewayState(ctx context.Context, conn *ec2.EC2, id string) r
 	}
 }
 
+func StatusLocalGatewayRouteTableState(ctx context.Context, conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindLocalGatewayRouteTableByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
 func StatusVPNConnectionState(ctx context.Context, conn *ec2.EC2, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindVPNConnectionByID(ctx, conn, id)
@@ -1217,6 +1233,26 @@ func StatusVPCEndpointServiceStateDeleted(ctx context.Context, conn *ec2.EC2, id
 	}
 }
 
+func StatusVPCEndpointServicePrivateDNSNameState(ctx context.Context, conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindVPCEndpointServiceConfigurationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.PrivateDnsNameConfiguration == nil {
+			return nil, "", nil
+		}
+
+		return output.PrivateDnsNameConfiguration, aws.StringValue(output.PrivateDnsNameConfiguration.State), nil
+	}
+}
+
 const (
 	VPCEndpointRouteTableAssociationStatusReady = "ready"
 )
@@ -1285,6 +1321,22 @@ func StatusSnapshotStorageTier(ctx context.Context, conn *ec2.EC2, id string) re
 	}
 }
 
+func statusFastSnapshotRestore(ctx context.Context, conn *ec2.EC2, availabilityZone, snapshotID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFastSnapshotRestoreByTwoPartKey(ctx, conn, availabilityZone, snapshotID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
 func StatusIPAMState(ctx context.Context, conn *ec2.EC2, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindIPAMByID(ctx, conn, id)
@@ -0,0 +1,45 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccVPCEndpointServicePrivateDNSVerification_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var svcCfg ec2.ServiceConfiguration
+	resourceName := "aws_vpc_endpoint_service_private_dns_verification.test"
+	serviceResourceName := "aws_vpc_endpoint_service.test"
+	rName := sdkacctest.RandomWithPrefix("tfacctest") // 32 character limit
+	domainName := acctest.RandomSubdomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCEndpointServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointServicePrivateDNSVerificationConfig_basic(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCEndpointServiceExists(ctx, serviceResourceName, &svcCfg),
+					resource.TestCheckResourceAttrPair(resourceName, "id", serviceResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_id", serviceResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_verification", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCEndpointServicePrivateDNSVerificationConfig_basic(rName, dnsName string) string {
+	return acctest.ConfigCompose(testAccVPCEndpointServiceConfig_privateDNSName(rName, dnsName), `
+resource "aws_vpc_endpoint_service_private_dns_verification" "test" {
+  service_id = aws_vpc_endpoint_service.test.id
+}
+`)
+}
@@ -0,0 +1,198 @@
+package ec2
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// networkPathBetweenTagKey marks the Network Insights Paths and Analyses created by aws_network_path_between.
+const networkPathBetweenTagKey = "terraform-provider-aws:network-path-between"
+
+func DataSourceNetworkPathBetween() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceNetworkPathBetweenRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"blocking_components": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"destination": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"destination_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"explanations": networkInsightsAnalysisExplanationsSchema,
+			"network_insights_analysis_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_insights_path_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"path_found": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ec2.ProtocolTcp,
+				ValidateFunc: validation.StringInSlice(ec2.Protocol_Values(), false),
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkPathBetweenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(map[string]interface{}{
+		networkPathBetweenTagKey: "true",
+	}))
+
+	source := d.Get("source").(string)
+	destination := d.Get("destination").(string)
+	protocol := d.Get("protocol").(string)
+	var destinationPort *int64
+	if v, ok := d.GetOk("destination_port"); ok {
+		destinationPort = aws.Int64(int64(v.(int)))
+	}
+
+	pathID, err := findOrCreateNetworkInsightsPath(ctx, conn, source, destination, protocol, destinationPort, tags)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "finding or creating EC2 Network Insights Path (%s to %s): %s", source, destination, err)
+	}
+
+	output, err := conn.StartNetworkInsightsAnalysisWithContext(ctx, &ec2.StartNetworkInsightsAnalysisInput{
+		NetworkInsightsPathId: aws.String(pathID),
+		TagSpecifications:     tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeNetworkInsightsAnalysis),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting EC2 Network Insights Analysis (%s): %s", pathID, err)
+	}
+
+	analysisID := aws.StringValue(output.NetworkInsightsAnalysis.NetworkInsightsAnalysisId)
+	analysis, err := WaitNetworkInsightsAnalysisCreated(ctx, conn, analysisID, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Network Insights Analysis (%s) create: %s", analysisID, err)
+	}
+
+	// Explanations name the blocking components only when no path is found.
+	var blockingComponents []interface{}
+	if !aws.BoolValue(analysis.NetworkPathFound) {
+		for _, v := range analysis.Explanations {
+			if v == nil || v.Component == nil {
+				continue
+			}
+
+			blockingComponents = append(blockingComponents, flattenAnalysisComponent(v.Component))
+		}
+	}
+
+	d.SetId(analysisID)
+	if err := d.Set("blocking_components", blockingComponents); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting blocking_components: %s", err)
+	}
+	if err := d.Set("explanations", flattenExplanations(analysis.Explanations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting explanations: %s", err)
+	}
+	d.Set("network_insights_analysis_id", analysisID)
+	d.Set("network_insights_path_id", pathID)
+	d.Set("path_found", analysis.NetworkPathFound)
+
+	return diags
+}
+
+// findOrCreateNetworkInsightsPath returns the ID of an existing Network Insights Path with the
+// specified source, destination, protocol and destination port, creating one with the specified tags if none exists.
+func findOrCreateNetworkInsightsPath(ctx context.Context, conn *ec2.EC2, source, destination, protocol string, destinationPort *int64, tags tftags.KeyValueTags) (string, error) {
+	filters := map[string]string{
+		"destination": destination,
+		"protocol":    protocol,
+		"source":      source,
+	}
+	if destinationPort != nil {
+		filters["destination-port"] = strconv.FormatInt(aws.Int64Value(destinationPort), 10)
+	}
+
+	paths, err := FindNetworkInsightsPaths(ctx, conn, &ec2.DescribeNetworkInsightsPathsInput{
+		Filters: BuildAttributeFilterList(filters),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	for _, v := range paths {
+		// Only reuse paths that are not further restricted by IP address or port.
+		if v.SourceIp != nil || v.DestinationIp != nil {
+			continue
+		}
+
+		if (v.DestinationPort == nil) != (destinationPort == nil) {
+			continue
+		}
+
+		return aws.StringValue(v.NetworkInsightsPathId), nil
+	}
+
+	input := &ec2.CreateNetworkInsightsPathInput{
+		Destination:       aws.String(destination),
+		DestinationPort:   destinationPort,
+		Protocol:          aws.String(protocol),
+		Source:            aws.String(source),
+		TagSpecifications: tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeNetworkInsightsPath),
+	}
+
+	output, err := conn.CreateNetworkInsightsPathWithContext(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(output.NetworkInsightsPath.NetworkInsightsPathId), nil
+}
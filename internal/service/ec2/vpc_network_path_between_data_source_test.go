@@ -0,0 +1,46 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccVPCNetworkPathBetweenDataSource_basic(t *testing.T) {
+	pathResourceName := "aws_ec2_network_insights_path.test"
+	datasourceName := "data.aws_network_path_between.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkPathBetweenDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "blocking_components.#", "0"),
+					resource.TestCheckResourceAttrSet(datasourceName, "network_insights_analysis_id"),
+					// The existing path with the same source, destination and protocol is reused.
+					resource.TestCheckResourceAttrPair(datasourceName, "network_insights_path_id", pathResourceName, "id"),
+					resource.TestCheckResourceAttr(datasourceName, "path_found", "true"),
+					resource.TestCheckResourceAttr(datasourceName, "protocol", "tcp"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCNetworkPathBetweenDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsAnalysisConfig_base(rName), `
+data "aws_network_path_between" "test" {
+  source      = aws_network_interface.test[0].id
+  destination = aws_network_interface.test[1].id
+
+  depends_on = [aws_ec2_network_insights_path.test]
+}
+`)
+}
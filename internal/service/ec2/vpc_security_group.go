@@ -750,8 +750,13 @@ func updateSecurityGroupRules(ctx context.Context, conn *ec2.EC2, d *schema.Reso
 		n = new(schema.Set)
 	}
 
-	os := SecurityGroupExpandRules(o.(*schema.Set))
-	ns := SecurityGroupExpandRules(n.(*schema.Set))
+	return modifySecurityGroupRules(ctx, conn, ruleType, group, o.(*schema.Set), n.(*schema.Set))
+}
+
+// modifySecurityGroupRules revokes the rules in o that are not in n and authorizes the rules in n that are not in o.
+func modifySecurityGroupRules(ctx context.Context, conn *ec2.EC2, ruleType string, group *ec2.SecurityGroup, o, n *schema.Set) error {
+	os := SecurityGroupExpandRules(o)
+	ns := SecurityGroupExpandRules(n)
 
 	del, err := ExpandIPPerms(group, SecurityGroupCollapseRules(ruleType, os.Difference(ns).List()))
 
@@ -0,0 +1,172 @@
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceSecurityGroupRulesExclusive() *schema.Resource {
+	// Unlike aws_security_group's ingress and egress, these rule sets are not Computed:
+	// omitting one removes every rule of that type from the security group.
+	ruleSetSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:       schema.TypeSet,
+			Optional:   true,
+			ConfigMode: schema.SchemaConfigModeAttr,
+			Elem:       securityGroupRuleNestedBlock,
+			Set:        SecurityGroupRuleHash,
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSecurityGroupRulesExclusivePut,
+		ReadWithoutTimeout:   resourceSecurityGroupRulesExclusiveRead,
+		UpdateWithoutTimeout: resourceSecurityGroupRulesExclusivePut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("security_group_id", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"egress":  ruleSetSchema(),
+			"ingress": ruleSetSchema(),
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSecurityGroupRulesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	securityGroupID := d.Get("security_group_id").(string)
+
+	conns.GlobalMutexKV.Lock(securityGroupID)
+	defer conns.GlobalMutexKV.Unlock(securityGroupID)
+
+	sg, err := FindSecurityGroupByID(ctx, conn, securityGroupID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Group (%s): %s", securityGroupID, err)
+	}
+
+	// Reconcile against the rules currently in AWS rather than those in state so that
+	// rules added outside of Terraform are revoked.
+	ruleTypes := []string{securityGroupRuleTypeIngress}
+	permissions := map[string][]*ec2.IpPermission{
+		securityGroupRuleTypeIngress: sg.IpPermissions,
+	}
+
+	// Egress rules only exist in VPC security groups.
+	if aws.StringValue(sg.VpcId) != "" {
+		ruleTypes = append(ruleTypes, securityGroupRuleTypeEgress)
+		permissions[securityGroupRuleTypeEgress] = sg.IpPermissionsEgress
+	}
+
+	for _, ruleType := range ruleTypes {
+		o := securityGroupRuleSetFromIPPermissions(sg, permissions[ruleType])
+		n := d.Get(ruleType).(*schema.Set)
+
+		if err := modifySecurityGroupRules(ctx, conn, ruleType, sg, o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Security Group (%s) %s rules: %s", securityGroupID, ruleType, err)
+		}
+	}
+
+	d.SetId(securityGroupID)
+
+	return append(diags, resourceSecurityGroupRulesExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceSecurityGroupRulesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	sg, err := FindSecurityGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Group (%s) not found, removing exclusive rules from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Group (%s): %s", d.Id(), err)
+	}
+
+	remoteIngressRules := SecurityGroupIPPermGather(d.Id(), sg.IpPermissions, sg.OwnerId)
+	remoteEgressRules := SecurityGroupIPPermGather(d.Id(), sg.IpPermissionsEgress, sg.OwnerId)
+
+	localIngressRules := d.Get("ingress").(*schema.Set).List()
+	localEgressRules := d.Get("egress").(*schema.Set).List()
+
+	ingressRules := MatchRules("ingress", localIngressRules, remoteIngressRules)
+	egressRules := MatchRules("egress", localEgressRules, remoteEgressRules)
+
+	if err := d.Set("ingress", ingressRules); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ingress: %s", err)
+	}
+
+	if err := d.Set("egress", egressRules); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting egress: %s", err)
+	}
+
+	d.Set("security_group_id", sg.GroupId)
+
+	return diags
+}
+
+// securityGroupRuleSetFromIPPermissions returns the specified permissions as a set of
+// rules in the same form as configured ingress and egress blocks.
+func securityGroupRuleSetFromIPPermissions(group *ec2.SecurityGroup, permissions []*ec2.IpPermission) *schema.Set {
+	rules := schema.NewSet(SecurityGroupRuleHash, nil)
+
+	for _, rule := range SecurityGroupIPPermGather(aws.StringValue(group.GroupId), permissions, group.OwnerId) {
+		tfMap := map[string]interface{}{
+			"description": "",
+			"from_port":   int(rule["from_port"].(int64)),
+			"protocol":    rule["protocol"].(string),
+			"self":        false,
+			"to_port":     int(rule["to_port"].(int64)),
+		}
+
+		for _, key := range []string{"cidr_blocks", "ipv6_cidr_blocks", "prefix_list_ids"} {
+			if v, ok := rule[key]; ok {
+				tfMap[key] = flex.FlattenStringValueList(v.([]string))
+			}
+		}
+
+		if v, ok := rule["description"]; ok {
+			tfMap["description"] = v.(string)
+		}
+
+		if v, ok := rule["security_groups"]; ok {
+			tfMap["security_groups"] = v.(*schema.Set)
+		}
+
+		if v, ok := rule["self"]; ok {
+			tfMap["self"] = v.(bool)
+		}
+
+		rules.Add(tfMap)
+	}
+
+	return rules
+}
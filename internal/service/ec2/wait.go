@@ -2032,6 +2032,48 @@ func WaitCustomerGatewayDeleted(ctx context.Context, conn *ec2.EC2, id string) (
 	return nil, err
 }
 
+func WaitLocalGatewayRouteTableCreated(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.LocalGatewayRouteTable, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{localGatewayRouteTableStatePending},
+		Target:  []string{localGatewayRouteTableStateAvailable},
+		Refresh: StatusLocalGatewayRouteTableState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.LocalGatewayRouteTable); ok {
+		if v := output.StateReason; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitLocalGatewayRouteTableDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.LocalGatewayRouteTable, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{localGatewayRouteTableStateAvailable, localGatewayRouteTableStateDeleting},
+		Target:  []string{},
+		Refresh: StatusLocalGatewayRouteTableState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.LocalGatewayRouteTable); ok {
+		if v := output.StateReason; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	natGatewayCreatedTimeout = 10 * time.Minute
 	natGatewayDeletedTimeout = 30 * time.Minute
@@ -2665,6 +2707,26 @@ func WaitVPCEndpointServiceDeleted(ctx context.Context, conn *ec2.EC2, id string
 	return nil, err
 }
 
+func WaitVPCEndpointServicePrivateDNSNameVerified(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.PrivateDnsNameConfiguration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{ec2.DnsNameStatePendingVerification},
+		Target:                    []string{ec2.DnsNameStateVerified},
+		Refresh:                   StatusVPCEndpointServicePrivateDNSNameState(ctx, conn, id),
+		Timeout:                   timeout,
+		Delay:                     5 * time.Second,
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.PrivateDnsNameConfiguration); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitVPCEndpointRouteTableAssociationDeleted(ctx context.Context, conn *ec2.EC2, vpcEndpointID, routeTableID string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:                   []string{VPCEndpointRouteTableAssociationStatusReady},
@@ -2762,6 +2824,46 @@ func waitEBSSnapshotTierArchive(ctx context.Context, conn *ec2.EC2, id string, t
 	return nil, err
 }
 
+func waitFastSnapshotRestoreCreated(ctx context.Context, conn *ec2.EC2, availabilityZone, snapshotID string, timeout time.Duration) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.FastSnapshotRestoreStateCodeEnabling, ec2.FastSnapshotRestoreStateCodeOptimizing},
+		Target:  []string{ec2.FastSnapshotRestoreStateCodeEnabled},
+		Refresh: statusFastSnapshotRestore(ctx, conn, availabilityZone, snapshotID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.DescribeFastSnapshotRestoreSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFastSnapshotRestoreDeleted(ctx context.Context, conn *ec2.EC2, availabilityZone, snapshotID string, timeout time.Duration) (*ec2.DescribeFastSnapshotRestoreSuccessItem, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.FastSnapshotRestoreStateCodeDisabling, ec2.FastSnapshotRestoreStateCodeOptimizing, ec2.FastSnapshotRestoreStateCodeEnabled},
+		Target:  []string{},
+		Refresh: statusFastSnapshotRestore(ctx, conn, availabilityZone, snapshotID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.DescribeFastSnapshotRestoreSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitIPAMCreated(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Ipam, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ec2.IpamStateCreateInProgress},
@@ -0,0 +1,19 @@
+package elasticbeanstalk
+
+const (
+	// https://docs.aws.amazon.com/elasticbeanstalk/latest/dg/command-options-general.html#command-options-general-elasticbeanstalkmanagedactions
+	optionNamespaceManagedActions               = "aws:elasticbeanstalk:managedactions"
+	optionNamespaceManagedActionsPlatformUpdate = "aws:elasticbeanstalk:managedactions:platformupdate"
+)
+
+const (
+	managedPlatformUpdateLevelMinor = "minor"
+	managedPlatformUpdateLevelPatch = "patch"
+)
+
+func managedPlatformUpdateLevel_Values() []string {
+	return []string{
+		managedPlatformUpdateLevelMinor,
+		managedPlatformUpdateLevelPatch,
+	}
+}
@@ -18,6 +18,7 @@ import ( // nosemgrep:ci.aws-sdk-go-multiple-service-imports
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -202,6 +203,38 @@ func ResourceEnvironment() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"managed_platform_updates": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"instance_refresh_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"preferred_start_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(Mon|Tue|Wed|Thu|Fri|Sat|Sun):([01]\d|2[0-3]):[0-5]\d$`), "must be in the format day:hour:minute, e.g. Sun:02:00"),
+						},
+						"update_level": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(managedPlatformUpdateLevel_Values(), false),
+						},
+					},
+				},
+			},
 			"queues": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -244,6 +277,10 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 		Tags:            Tags(tags.IgnoreElasticbeanstalk()),
 	}
 
+	if v, ok := d.GetOk("managed_platform_updates"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		createOpts.OptionSettings = append(createOpts.OptionSettings, expandManagedPlatformUpdates(v.([]interface{})[0].(map[string]interface{}))...)
+	}
+
 	if desc != "" {
 		createOpts.Description = aws.String(desc)
 	}
@@ -414,6 +451,13 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 		updateOpts.OptionSettings = add
 	}
 
+	if d.HasChange("managed_platform_updates") {
+		if v, ok := d.GetOk("managed_platform_updates"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			hasChange = true
+			updateOpts.OptionSettings = append(updateOpts.OptionSettings, expandManagedPlatformUpdates(v.([]interface{})[0].(map[string]interface{}))...)
+		}
+	}
+
 	if d.HasChange("platform_arn") {
 		hasChange = true
 		if v, ok := d.GetOk("platform_arn"); ok {
@@ -682,6 +726,7 @@ func fetchEnvironmentSettings(ctx context.Context, d *schema.ResourceData, meta
 
 func resourceEnvironmentSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
 	log.Printf("[DEBUG] Elastic Beanstalk environment settings read %s: id %s", d.Get("name").(string), d.Id())
 
 	allSettings, err := fetchEnvironmentSettings(ctx, d, meta)
@@ -691,17 +736,48 @@ func resourceEnvironmentSettingsRead(ctx context.Context, d *schema.ResourceData
 
 	settings := d.Get("setting").(*schema.Set)
 
-	// perform the set operation with only name/namespace as keys, excluding value
-	// this is so we override things in the settings resource data key with updated values
-	// from the api.  we skip values we didn't know about before because there are so many
-	// defaults set by the eb api that we would delete many useful defaults.
-	//
-	// there is likely a better way to do this
-	allSettingsKeySet := schema.NewSet(optionSettingKeyHash, allSettings.List())
-	settingsKeySet := schema.NewSet(optionSettingKeyHash, settings.List())
-	updatedSettingsKeySet := allSettingsKeySet.Intersection(settingsKeySet)
+	// Only the settings present in configuration are tracked in "setting", as
+	// the Elastic Beanstalk API returns a large number of defaults that would
+	// otherwise be removed.
+	// A configured value is kept when it is equivalent to the value returned
+	// by the API, or when the API omits the option and the configured value
+	// is the option's default, so that normalization by the API does not
+	// produce a perpetual diff.
+	var options map[string]*elasticbeanstalk.ConfigurationOptionDescription
+	if settings.Len() > 0 {
+		options, err = findConfigurationOptions(ctx, conn, d.Get("application").(string), d.Get("name").(string))
 
-	updatedSettings := schema.NewSet(optionSettingValueHash, updatedSettingsKeySet.List())
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Elastic Beanstalk Environment (%s) configuration options: %s", d.Id(), err)
+		}
+	}
+
+	actualSettings := make(map[string]map[string]interface{})
+	for _, v := range allSettings.List() {
+		tfMap := v.(map[string]interface{})
+		actualSettings[optionSettingKey(tfMap)] = tfMap
+	}
+
+	updatedSettings := schema.NewSet(optionSettingValueHash, nil)
+	for _, v := range settings.List() {
+		tfMap := v.(map[string]interface{})
+		option := options[optionKey(tfMap["namespace"].(string), tfMap["name"].(string))]
+		value, _ := tfMap["value"].(string)
+
+		if actual, ok := actualSettings[optionSettingKey(tfMap)]; ok {
+			if actualValue, _ := actual["value"].(string); optionSettingValuesEquivalent(option, value, actualValue) {
+				updatedSettings.Add(tfMap)
+			} else {
+				updatedSettings.Add(actual)
+			}
+
+			continue
+		}
+
+		if option != nil && option.DefaultValue != nil && optionSettingValuesEquivalent(option, value, aws.StringValue(option.DefaultValue)) {
+			updatedSettings.Add(tfMap)
+		}
+	}
 
 	if err := d.Set("all_settings", allSettings.List()); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
@@ -711,9 +787,37 @@ func resourceEnvironmentSettingsRead(ctx context.Context, d *schema.ResourceData
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if err := d.Set("managed_platform_updates", flattenManagedPlatformUpdates(actualSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting managed_platform_updates: %s", err)
+	}
+
 	return diags
 }
 
+func findConfigurationOptions(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, applicationName, environmentName string) (map[string]*elasticbeanstalk.ConfigurationOptionDescription, error) {
+	input := &elasticbeanstalk.DescribeConfigurationOptionsInput{
+		ApplicationName: aws.String(applicationName),
+		EnvironmentName: aws.String(environmentName),
+	}
+
+	output, err := conn.DescribeConfigurationOptionsWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	options := make(map[string]*elasticbeanstalk.ConfigurationOptionDescription)
+	for _, v := range output.Options {
+		if v == nil {
+			continue
+		}
+
+		options[optionKey(aws.StringValue(v.Namespace), aws.StringValue(v.Name))] = v
+	}
+
+	return options, nil
+}
+
 func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
@@ -903,17 +1007,41 @@ func optionSettingValueHash(v interface{}) int {
 	return create.StringHashcode(hk)
 }
 
-func optionSettingKeyHash(v interface{}) int {
-	rd := v.(map[string]interface{})
-	namespace := rd["namespace"].(string)
-	optionName := rd["name"].(string)
+func optionSettingKey(rd map[string]interface{}) string {
 	var resourceName string
 	if v, ok := rd["resource"].(string); ok {
 		resourceName = v
 	}
-	hk := fmt.Sprintf("%s:%s%s", namespace, optionName, resourceName)
-	log.Printf("[DEBUG] Elastic Beanstalk optionSettingKeyHash(%#v): %s: hk=%s,hc=%d", v, optionName, hk, create.StringHashcode(hk))
-	return create.StringHashcode(hk)
+	return optionKey(rd["namespace"].(string), rd["name"].(string)) + resourceName
+}
+
+func optionKey(namespace, optionName string) string {
+	return fmt.Sprintf("%s:%s", namespace, optionName)
+}
+
+// optionSettingValuesEquivalent returns whether two values of an option are
+// equivalent, taking into account how the API normalizes the option's values.
+func optionSettingValuesEquivalent(option *elasticbeanstalk.ConfigurationOptionDescription, a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	if sortValues(a) == sortValues(b) {
+		return true
+	}
+
+	if v1, err := structure.NormalizeJsonString(a); err == nil {
+		if v2, err := structure.NormalizeJsonString(b); err == nil && v1 == v2 {
+			return true
+		}
+	}
+
+	// Enumerated values, e.g. "true" and "false", are case-insensitive.
+	if option != nil && len(option.ValueOptions) > 0 && strings.EqualFold(a, b) {
+		return true
+	}
+
+	return false
 }
 
 func sortValues(v string) string {
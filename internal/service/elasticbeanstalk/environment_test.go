@@ -447,6 +447,98 @@ func TestAccElasticBeanstalkEnvironment_BeanstalkEnv_platformARN(t *testing.T) {
 	})
 }
 
+func TestAccElasticBeanstalkEnvironment_BeanstalkEnv_settingDefaultValue(t *testing.T) {
+	ctx := acctest.Context(t)
+	var app elasticbeanstalk.EnvironmentDescription
+
+	resourceName := "aws_elastic_beanstalk_environment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_settingDefaultValue(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "setting.*", map[string]string{
+						"namespace": "aws:ec2:vpc",
+						"name":      "AssociatePublicIpAddress",
+						"value":     "True",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "setting.*", map[string]string{
+						"namespace": "aws:elasticbeanstalk:command",
+						"name":      "DeploymentPolicy",
+						"value":     "AllAtOnce",
+					}),
+				),
+			},
+			{
+				Config:   testAccEnvironmentConfig_settingDefaultValue(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkEnvironment_BeanstalkEnv_managedPlatformUpdates(t *testing.T) {
+	ctx := acctest.Context(t)
+	var app elasticbeanstalk.EnvironmentDescription
+
+	resourceName := "aws_elastic_beanstalk_environment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_managedPlatformUpdates(rName, true, "Sun:02:00", "minor"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "managed_platform_updates.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_platform_updates.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "managed_platform_updates.0.instance_refresh_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "managed_platform_updates.0.preferred_start_time", "Sun:02:00"),
+					resource.TestCheckResourceAttr(resourceName, "managed_platform_updates.0.update_level", "minor"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"setting",
+					"wait_for_ready_timeout",
+				},
+			},
+			{
+				Config: testAccEnvironmentConfig_managedPlatformUpdates(rName, true, "Wed:10:30", "patch"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "managed_platform_updates.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_platform_updates.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "managed_platform_updates.0.preferred_start_time", "Wed:10:30"),
+					resource.TestCheckResourceAttr(resourceName, "managed_platform_updates.0.update_level", "patch"),
+				),
+			},
+			{
+				Config: testAccEnvironmentConfig_managedPlatformUpdates(rName, false, "Wed:10:30", "patch"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "managed_platform_updates.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_platform_updates.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccVerifyConfig(ctx context.Context, env *elasticbeanstalk.EnvironmentDescription, expected []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if env == nil {
@@ -1696,3 +1788,116 @@ EOF
 }
 `, rName, publicKey, email)
 }
+
+func testAccEnvironmentConfig_settingDefaultValue(rName string) string {
+	return testAccEnvironmentConfig_base(rName) + fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment" "test" {
+  application         = aws_elastic_beanstalk_application.test.name
+  name                = %[1]q
+  solution_stack_name = data.aws_elastic_beanstalk_solution_stack.test.name
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "VPCId"
+    value     = aws_vpc.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "Subnets"
+    value     = aws_subnet.test.id
+  }
+
+  # The API returns this value in lower case.
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "AssociatePublicIpAddress"
+    value     = "True"
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "SecurityGroups"
+    value     = aws_security_group.test.id
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "IamInstanceProfile"
+    value     = aws_iam_instance_profile.test.name
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "ServiceRole"
+    value     = aws_iam_role.service_role.name
+  }
+
+  # Default value for the option.
+  setting {
+    namespace = "aws:elasticbeanstalk:command"
+    name      = "DeploymentPolicy"
+    value     = "AllAtOnce"
+  }
+}
+`, rName)
+}
+
+func testAccEnvironmentConfig_managedPlatformUpdates(rName string, enabled bool, preferredStartTime, updateLevel string) string {
+	return testAccEnvironmentConfig_base(rName) + fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment" "test" {
+  application         = aws_elastic_beanstalk_application.test.name
+  name                = %[1]q
+  solution_stack_name = data.aws_elastic_beanstalk_solution_stack.test.name
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "VPCId"
+    value     = aws_vpc.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "Subnets"
+    value     = aws_subnet.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "AssociatePublicIpAddress"
+    value     = "true"
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "SecurityGroups"
+    value     = aws_security_group.test.id
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "IamInstanceProfile"
+    value     = aws_iam_instance_profile.test.name
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "ServiceRole"
+    value     = aws_iam_role.service_role.name
+  }
+
+  # Managed platform updates require enhanced health reporting.
+  setting {
+    namespace = "aws:elasticbeanstalk:healthreporting:system"
+    name      = "SystemType"
+    value     = "enhanced"
+  }
+
+  managed_platform_updates {
+    enabled              = %[2]t
+    preferred_start_time = %[3]q
+    update_level         = %[4]q
+  }
+}
+`, rName, enabled, preferredStartTime, updateLevel)
+}
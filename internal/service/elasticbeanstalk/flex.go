@@ -1,6 +1,9 @@
 package elasticbeanstalk
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 )
@@ -94,3 +97,67 @@ func flattenResourceLifecycleConfig(rlc *elasticbeanstalk.ApplicationResourceLif
 
 	return result
 }
+
+func expandManagedPlatformUpdates(tfMap map[string]interface{}) []*elasticbeanstalk.ConfigurationOptionSetting {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObjects := []*elasticbeanstalk.ConfigurationOptionSetting{
+		{
+			Namespace:  aws.String(optionNamespaceManagedActions),
+			OptionName: aws.String("ManagedActionsEnabled"),
+			Value:      aws.String(strconv.FormatBool(tfMap["enabled"].(bool))),
+		},
+		{
+			Namespace:  aws.String(optionNamespaceManagedActionsPlatformUpdate),
+			OptionName: aws.String("InstanceRefreshEnabled"),
+			Value:      aws.String(strconv.FormatBool(tfMap["instance_refresh_enabled"].(bool))),
+		},
+	}
+
+	if v, ok := tfMap["preferred_start_time"].(string); ok && v != "" {
+		apiObjects = append(apiObjects, &elasticbeanstalk.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionNamespaceManagedActions),
+			OptionName: aws.String("PreferredStartTime"),
+			Value:      aws.String(v),
+		})
+	}
+
+	if v, ok := tfMap["update_level"].(string); ok && v != "" {
+		apiObjects = append(apiObjects, &elasticbeanstalk.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionNamespaceManagedActionsPlatformUpdate),
+			OptionName: aws.String("UpdateLevel"),
+			Value:      aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+// flattenManagedPlatformUpdates flattens the managed platform update options
+// from all of an environment's option settings, keyed by optionSettingKey.
+func flattenManagedPlatformUpdates(settings map[string]map[string]interface{}) []interface{} {
+	value := func(namespace, optionName string) string {
+		if v, ok := settings[optionKey(namespace, optionName)]; ok {
+			s, _ := v["value"].(string)
+			return s
+		}
+		return ""
+	}
+
+	enabled := value(optionNamespaceManagedActions, "ManagedActionsEnabled")
+
+	if enabled == "" {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled":                  strings.EqualFold(enabled, "true"),
+		"instance_refresh_enabled": strings.EqualFold(value(optionNamespaceManagedActionsPlatformUpdate, "InstanceRefreshEnabled"), "true"),
+		"preferred_start_time":     value(optionNamespaceManagedActions, "PreferredStartTime"),
+		"update_level":             value(optionNamespaceManagedActionsPlatformUpdate, "UpdateLevel"),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -137,7 +137,7 @@ func ResourceCluster() *schema.Resource {
 				ForceNew:      true,
 				Computed:      true,
 				MaxItems:      1,
-				Elem:          instanceFleetConfigSchema(),
+				Elem:          coreInstanceFleetConfigSchema(),
 				ConflictsWith: []string{"core_instance_group", "master_instance_group"},
 			},
 			"core_instance_group": {
@@ -448,6 +448,10 @@ func ResourceCluster() *schema.Resource {
 					},
 				},
 			},
+			"master_private_dns": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"master_public_dns": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -736,6 +740,17 @@ func instanceFleetConfigSchema() *schema.Resource {
 	}
 }
 
+// coreInstanceFleetConfigSchema returns the schema for the core instance fleet.
+// Unlike the master instance fleet, its target capacities can be modified in place.
+func coreInstanceFleetConfigSchema() *schema.Resource {
+	r := instanceFleetConfigSchema()
+
+	r.Schema["target_on_demand_capacity"].ForceNew = false
+	r.Schema["target_spot_capacity"].ForceNew = false
+
+	return r
+}
+
 func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EMRConn()
@@ -1093,6 +1108,18 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("log_encryption_kms_key_id", cluster.LogEncryptionKmsKeyId)
 	d.Set("log_uri", cluster.LogUri)
 	d.Set("master_public_dns", cluster.MasterPublicDnsName)
+
+	masterInstances, err := FindRunningMasterInstances(ctx, conn, cluster)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EMR Cluster (%s) master instances: %s", d.Id(), err)
+	}
+
+	if len(masterInstances) > 0 {
+		d.Set("master_private_dns", masterInstances[0].PrivateDnsName)
+	} else {
+		d.Set("master_private_dns", nil)
+	}
 	d.Set("visible_to_all_users", cluster.VisibleToAllUsers)
 	d.Set("ebs_root_volume_size", cluster.EbsRootVolumeSize)
 	d.Set("scale_down_behavior", cluster.ScaleDownBehavior)
@@ -1335,6 +1362,40 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChanges("core_instance_fleet.0.target_on_demand_capacity", "core_instance_fleet.0.target_spot_capacity") {
+		instanceFleetID := d.Get("core_instance_fleet.0.id").(string)
+
+		input := &emr.ModifyInstanceFleetInput{
+			ClusterId: aws.String(d.Id()),
+			InstanceFleet: &emr.InstanceFleetModifyConfig{
+				InstanceFleetId:        aws.String(instanceFleetID),
+				TargetOnDemandCapacity: aws.Int64(int64(d.Get("core_instance_fleet.0.target_on_demand_capacity").(int))),
+				TargetSpotCapacity:     aws.Int64(int64(d.Get("core_instance_fleet.0.target_spot_capacity").(int))),
+			},
+		}
+
+		if _, err := conn.ModifyInstanceFleetWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying EMR Cluster (%s) Instance Fleet (%s): %s", d.Id(), instanceFleetID, err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{
+				emr.InstanceFleetStateBootstrapping,
+				emr.InstanceFleetStateProvisioning,
+				emr.InstanceFleetStateResizing,
+			},
+			Target:     []string{emr.InstanceFleetStateRunning},
+			Refresh:    instanceFleetStateRefresh(ctx, conn, d.Id(), instanceFleetID),
+			Timeout:    75 * time.Minute,
+			Delay:      10 * time.Second,
+			MinTimeout: 30 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EMR Cluster (%s) Instance Fleet (%s) modification: %s", d.Id(), instanceFleetID, err)
+		}
+	}
+
 	if d.HasChange("instance_group") {
 		o, n := d.GetChange("instance_group")
 		oSet := o.(*schema.Set).List()
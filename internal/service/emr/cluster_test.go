@@ -1583,6 +1583,43 @@ func TestAccEMRCluster_InstanceFleet_basic(t *testing.T) {
 	})
 }
 
+func TestAccEMRCluster_InstanceFleet_coreCapacity(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster1, cluster2 emr.Cluster
+
+	resourceName := "aws_emr_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, emr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_instanceFleetCoreCapacity(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.target_on_demand_capacity", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "master_private_dns"),
+					resource.TestCheckResourceAttrSet(resourceName, "master_public_dns"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_instanceFleetCoreCapacity(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.provisioned_on_demand_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "core_instance_fleet.0.target_on_demand_capacity", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEMRCluster_InstanceFleetMaster_only(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cluster emr.Cluster
@@ -3887,6 +3924,55 @@ resource "aws_route_table_association" "test2" {
 `, rName))
 }
 
+func testAccClusterConfig_instanceFleetCoreCapacity(rName string, capacity int) string {
+	return acctest.ConfigCompose(
+		testAccClusterConfig_baseVPC(rName, false),
+		testAccClusterConfig_baseIAMServiceRole(rName),
+		testAccClusterConfig_baseIAMInstanceProfile(rName),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_emr_cluster" "test" {
+  name          = %[1]q
+  release_label = "emr-5.30.1"
+  applications  = ["Hadoop", "Hive"]
+  log_uri       = "s3n://terraform/testlog/"
+
+  master_instance_fleet {
+    instance_type_configs {
+      instance_type = "m4.large"
+    }
+
+    target_on_demand_capacity = 1
+  }
+
+  core_instance_fleet {
+    instance_type_configs {
+      instance_type     = "m4.large"
+      weighted_capacity = 1
+    }
+
+    name                      = "core fleet"
+    target_on_demand_capacity = %[2]d
+  }
+
+  service_role = aws_iam_role.emr_service.arn
+  depends_on = [
+    aws_route_table_association.test,
+    aws_iam_role_policy_attachment.emr_service,
+    aws_iam_role_policy_attachment.emr_instance_profile,
+  ]
+
+  ec2_attributes {
+    subnet_id                         = aws_subnet.test.id
+    emr_managed_master_security_group = aws_security_group.test.id
+    emr_managed_slave_security_group  = aws_security_group.test.id
+    instance_profile                  = aws_iam_instance_profile.emr_instance_profile.arn
+  }
+}
+`, rName, capacity))
+}
+
 func testAccClusterConfig_instanceFleetsMasterOnly(rName string) string {
 	return acctest.ConfigCompose(
 		testAccClusterConfig_baseVPC(rName, false),
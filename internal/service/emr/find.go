@@ -116,3 +116,50 @@ func FindStudioSessionMappingByID(ctx context.Context, conn *emr.EMR, id string)
 
 	return output.SessionMapping, nil
 }
+
+func FindInstances(ctx context.Context, conn *emr.EMR, input *emr.ListInstancesInput) ([]*emr.Instance, error) {
+	var output []*emr.Instance
+
+	err := conn.ListInstancesPagesWithContext(ctx, input, func(page *emr.ListInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Instances {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeClusterNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindRunningMasterInstances returns the running primary (master) node instances of the specified cluster.
+func FindRunningMasterInstances(ctx context.Context, conn *emr.EMR, cluster *emr.Cluster) ([]*emr.Instance, error) {
+	input := &emr.ListInstancesInput{
+		ClusterId:      cluster.Id,
+		InstanceStates: aws.StringSlice([]string{emr.InstanceStateRunning}),
+	}
+
+	if aws.StringValue(cluster.InstanceCollectionType) == emr.InstanceCollectionTypeInstanceFleet {
+		input.InstanceFleetType = aws.String(emr.InstanceFleetTypeMaster)
+	} else {
+		input.InstanceGroupTypes = aws.StringSlice([]string{emr.InstanceGroupTypeMaster})
+	}
+
+	return FindInstances(ctx, conn, input)
+}
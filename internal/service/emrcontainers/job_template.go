@@ -0,0 +1,780 @@
+package emrcontainers
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceJobTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobTemplateCreate,
+		ReadWithoutTimeout:   resourceJobTemplateRead,
+		UpdateWithoutTimeout: resourceJobTemplateUpdate,
+		DeleteWithoutTimeout: resourceJobTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_template_data": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"configuration_overrides": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"application_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"classification": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"configurations": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"classification": {
+																Type:     schema.TypeString,
+																Optional: true,
+																ForceNew: true,
+															},
+															"properties": {
+																Type:     schema.TypeMap,
+																Optional: true,
+																ForceNew: true,
+																Elem:     &schema.Schema{Type: schema.TypeString},
+															},
+														},
+													},
+												},
+												"properties": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"monitoring_configuration": {
+										Type:     schema.TypeList,
+										MaxItems: 1,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cloud_watch_monitoring_configuration": {
+													Type:     schema.TypeList,
+													MaxItems: 1,
+													Optional: true,
+													ForceNew: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"log_group_name": {
+																Type:     schema.TypeString,
+																Required: true,
+																ForceNew: true,
+															},
+															"log_stream_name_prefix": {
+																Type:     schema.TypeString,
+																Optional: true,
+																ForceNew: true,
+															},
+														},
+													},
+												},
+												"persistent_app_ui": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(emrcontainers.PersistentAppUI_Values(), false),
+												},
+												"s3_monitoring_configuration": {
+													Type:     schema.TypeList,
+													MaxItems: 1,
+													Optional: true,
+													ForceNew: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"log_uri": {
+																Type:     schema.TypeString,
+																Required: true,
+																ForceNew: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"execution_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"job_driver": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"spark_sql_job_driver": {
+										Type:         schema.TypeList,
+										MaxItems:     1,
+										Optional:     true,
+										ForceNew:     true,
+										ExactlyOneOf: []string{"job_template_data.0.job_driver.0.spark_sql_job_driver", "job_template_data.0.job_driver.0.spark_submit_job_driver"},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"entry_point": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"spark_sql_parameters": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"spark_submit_job_driver": {
+										Type:         schema.TypeList,
+										MaxItems:     1,
+										Optional:     true,
+										ForceNew:     true,
+										ExactlyOneOf: []string{"job_template_data.0.job_driver.0.spark_sql_job_driver", "job_template_data.0.job_driver.0.spark_submit_job_driver"},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"entry_point": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"entry_point_arguments": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"spark_submit_parameters": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"job_tags": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"parameter_configuration": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"default_value": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      emrcontainers.TemplateParameterDataTypeString,
+										ValidateFunc: validation.StringInSlice(emrcontainers.TemplateParameterDataType_Values(), false),
+									},
+								},
+							},
+						},
+						"release_label": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`[.\-_/#A-Za-z0-9]+`), "must contain only alphanumeric, hyphen, underscore, dot and # characters"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceJobTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EMRContainersConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &emrcontainers.CreateJobTemplateInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("job_template_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.JobTemplateData = expandJobTemplateData(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[INFO] Creating EMR Containers Job Template: %s", input)
+	output, err := conn.CreateJobTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating EMR Containers Job Template (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceJobTemplateRead(ctx, d, meta)
+}
+
+func resourceJobTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EMRContainersConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	jt, err := FindJobTemplateByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EMR Containers Job Template %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading EMR Containers Job Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", jt.Arn)
+	if jt.JobTemplateData != nil {
+		if err := d.Set("job_template_data", []interface{}{flattenJobTemplateData(jt.JobTemplateData)}); err != nil {
+			return diag.Errorf("setting job_template_data: %s", err)
+		}
+	} else {
+		d.Set("job_template_data", nil)
+	}
+	d.Set("kms_key_arn", jt.KmsKeyArn)
+	d.Set("name", jt.Name)
+
+	tags := KeyValueTags(jt.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceJobTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EMRContainersConn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating EMR Containers Job Template (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceJobTemplateRead(ctx, d, meta)
+}
+
+func resourceJobTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EMRContainersConn()
+
+	log.Printf("[INFO] Deleting EMR Containers Job Template: %s", d.Id())
+	_, err := conn.DeleteJobTemplateWithContext(ctx, &emrcontainers.DeleteJobTemplateInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, emrcontainers.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting EMR Containers Job Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandJobTemplateData(tfMap map[string]interface{}) *emrcontainers.JobTemplateData {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.JobTemplateData{}
+
+	if v, ok := tfMap["configuration_overrides"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ConfigurationOverrides = expandParametricConfigurationOverrides(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["execution_role_arn"].(string); ok && v != "" {
+		apiObject.ExecutionRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["job_driver"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.JobDriver = expandJobDriver(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["job_tags"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.JobTags = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["parameter_configuration"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ParameterConfiguration = expandTemplateParameterConfigurations(v.List())
+	}
+
+	if v, ok := tfMap["release_label"].(string); ok && v != "" {
+		apiObject.ReleaseLabel = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandParametricConfigurationOverrides(tfMap map[string]interface{}) *emrcontainers.ParametricConfigurationOverrides {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.ParametricConfigurationOverrides{}
+
+	if v, ok := tfMap["application_configuration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ApplicationConfiguration = expandConfigurations(v)
+	}
+
+	if v, ok := tfMap["monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MonitoringConfiguration = expandParametricMonitoringConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandConfiguration(tfMap map[string]interface{}) *emrcontainers.Configuration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.Configuration{}
+
+	if v, ok := tfMap["classification"].(string); ok && v != "" {
+		apiObject.Classification = aws.String(v)
+	}
+
+	if v, ok := tfMap["configurations"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Configurations = expandConfigurations(v)
+	}
+
+	if v, ok := tfMap["properties"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Properties = flex.ExpandStringMap(v)
+	}
+
+	return apiObject
+}
+
+func expandConfigurations(tfList []interface{}) []*emrcontainers.Configuration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*emrcontainers.Configuration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandConfiguration(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandParametricMonitoringConfiguration(tfMap map[string]interface{}) *emrcontainers.ParametricMonitoringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.ParametricMonitoringConfiguration{}
+
+	if v, ok := tfMap["cloud_watch_monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		cw := &emrcontainers.ParametricCloudWatchMonitoringConfiguration{}
+
+		if v, ok := tfMap["log_group_name"].(string); ok && v != "" {
+			cw.LogGroupName = aws.String(v)
+		}
+
+		if v, ok := tfMap["log_stream_name_prefix"].(string); ok && v != "" {
+			cw.LogStreamNamePrefix = aws.String(v)
+		}
+
+		apiObject.CloudWatchMonitoringConfiguration = cw
+	}
+
+	if v, ok := tfMap["persistent_app_ui"].(string); ok && v != "" {
+		apiObject.PersistentAppUI = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		s3 := &emrcontainers.ParametricS3MonitoringConfiguration{}
+
+		if v, ok := tfMap["log_uri"].(string); ok && v != "" {
+			s3.LogUri = aws.String(v)
+		}
+
+		apiObject.S3MonitoringConfiguration = s3
+	}
+
+	return apiObject
+}
+
+func expandJobDriver(tfMap map[string]interface{}) *emrcontainers.JobDriver {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.JobDriver{}
+
+	if v, ok := tfMap["spark_sql_job_driver"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		driver := &emrcontainers.SparkSqlJobDriver{}
+
+		if v, ok := tfMap["entry_point"].(string); ok && v != "" {
+			driver.EntryPoint = aws.String(v)
+		}
+
+		if v, ok := tfMap["spark_sql_parameters"].(string); ok && v != "" {
+			driver.SparkSqlParameters = aws.String(v)
+		}
+
+		apiObject.SparkSqlJobDriver = driver
+	}
+
+	if v, ok := tfMap["spark_submit_job_driver"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		driver := &emrcontainers.SparkSubmitJobDriver{}
+
+		if v, ok := tfMap["entry_point"].(string); ok && v != "" {
+			driver.EntryPoint = aws.String(v)
+		}
+
+		if v, ok := tfMap["entry_point_arguments"].([]interface{}); ok && len(v) > 0 {
+			driver.EntryPointArguments = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["spark_submit_parameters"].(string); ok && v != "" {
+			driver.SparkSubmitParameters = aws.String(v)
+		}
+
+		apiObject.SparkSubmitJobDriver = driver
+	}
+
+	return apiObject
+}
+
+func expandTemplateParameterConfigurations(tfList []interface{}) map[string]*emrcontainers.TemplateParameterConfiguration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*emrcontainers.TemplateParameterConfiguration)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &emrcontainers.TemplateParameterConfiguration{}
+
+		if v, ok := tfMap["default_value"].(string); ok && v != "" {
+			apiObject.DefaultValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		apiObjects[tfMap["name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func flattenJobTemplateData(apiObject *emrcontainers.JobTemplateData) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ConfigurationOverrides; v != nil {
+		tfMap["configuration_overrides"] = []interface{}{flattenParametricConfigurationOverrides(v)}
+	}
+
+	if v := apiObject.ExecutionRoleArn; v != nil {
+		tfMap["execution_role_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.JobDriver; v != nil {
+		tfMap["job_driver"] = []interface{}{flattenJobDriver(v)}
+	}
+
+	if v := apiObject.JobTags; v != nil {
+		tfMap["job_tags"] = aws.StringValueMap(v)
+	}
+
+	if v := apiObject.ParameterConfiguration; v != nil {
+		tfMap["parameter_configuration"] = flattenTemplateParameterConfigurations(v)
+	}
+
+	if v := apiObject.ReleaseLabel; v != nil {
+		tfMap["release_label"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenParametricConfigurationOverrides(apiObject *emrcontainers.ParametricConfigurationOverrides) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ApplicationConfiguration; v != nil {
+		tfMap["application_configuration"] = flattenConfigurations(v)
+	}
+
+	if v := apiObject.MonitoringConfiguration; v != nil {
+		tfMap["monitoring_configuration"] = []interface{}{flattenParametricMonitoringConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+func flattenConfiguration(apiObject *emrcontainers.Configuration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Classification; v != nil {
+		tfMap["classification"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Configurations; v != nil {
+		tfMap["configurations"] = flattenConfigurations(v)
+	}
+
+	if v := apiObject.Properties; v != nil {
+		tfMap["properties"] = aws.StringValueMap(v)
+	}
+
+	return tfMap
+}
+
+func flattenConfigurations(apiObjects []*emrcontainers.Configuration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenConfiguration(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenParametricMonitoringConfiguration(apiObject *emrcontainers.ParametricMonitoringConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CloudWatchMonitoringConfiguration; v != nil {
+		tfMap["cloud_watch_monitoring_configuration"] = []interface{}{map[string]interface{}{
+			"log_group_name":         aws.StringValue(v.LogGroupName),
+			"log_stream_name_prefix": aws.StringValue(v.LogStreamNamePrefix),
+		}}
+	}
+
+	if v := apiObject.PersistentAppUI; v != nil {
+		tfMap["persistent_app_ui"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.S3MonitoringConfiguration; v != nil {
+		tfMap["s3_monitoring_configuration"] = []interface{}{map[string]interface{}{
+			"log_uri": aws.StringValue(v.LogUri),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenJobDriver(apiObject *emrcontainers.JobDriver) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SparkSqlJobDriver; v != nil {
+		tfMap["spark_sql_job_driver"] = []interface{}{map[string]interface{}{
+			"entry_point":          aws.StringValue(v.EntryPoint),
+			"spark_sql_parameters": aws.StringValue(v.SparkSqlParameters),
+		}}
+	}
+
+	if v := apiObject.SparkSubmitJobDriver; v != nil {
+		tfMap["spark_submit_job_driver"] = []interface{}{map[string]interface{}{
+			"entry_point":             aws.StringValue(v.EntryPoint),
+			"entry_point_arguments":   aws.StringValueSlice(v.EntryPointArguments),
+			"spark_submit_parameters": aws.StringValue(v.SparkSubmitParameters),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenTemplateParameterConfigurations(apiObjects map[string]*emrcontainers.TemplateParameterConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"default_value": aws.StringValue(apiObject.DefaultValue),
+			"name":          name,
+			"type":          aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func FindJobTemplateByID(ctx context.Context, conn *emrcontainers.EMRContainers, id string) (*emrcontainers.JobTemplate, error) {
+	input := &emrcontainers.DescribeJobTemplateInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.DescribeJobTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, emrcontainers.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JobTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.JobTemplate, nil
+}
@@ -0,0 +1,357 @@
+package emrcontainers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfemrcontainers "github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEMRContainersJobTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v emrcontainers.JobTemplate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrcontainers_job_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "job_template_data.0.execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.job_driver.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.job_driver.0.spark_submit_job_driver.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.job_driver.0.spark_submit_job_driver.0.entry_point", "default"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.release_label", "emr-6.10.0-latest"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEMRContainersJobTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v emrcontainers.JobTemplate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrcontainers_job_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfemrcontainers.ResourceJobTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEMRContainersJobTemplate_full(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v emrcontainers.JobTemplate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrcontainers_job_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_full(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.configuration_overrides.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.configuration_overrides.0.application_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.configuration_overrides.0.application_configuration.0.classification", "spark-defaults"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.configuration_overrides.0.application_configuration.0.properties.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.configuration_overrides.0.monitoring_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "job_template_data.0.configuration_overrides.0.monitoring_configuration.0.cloud_watch_monitoring_configuration.0.log_group_name", "aws_cloudwatch_log_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.configuration_overrides.0.monitoring_configuration.0.persistent_app_ui", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.job_driver.0.spark_submit_job_driver.0.entry_point_arguments.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.job_driver.0.spark_submit_job_driver.0.spark_submit_parameters", "--conf spark.executor.instances=${ExecutorInstances}"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.job_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.parameter_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "job_template_data.0.parameter_configuration.*", map[string]string{
+						"default_value": "2",
+						"name":          "ExecutorInstances",
+						"type":          "NUMBER",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEMRContainersJobTemplate_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v emrcontainers.JobTemplate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrcontainers_job_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobTemplateConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccJobTemplateConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJobTemplateExists(ctx context.Context, n string, v *emrcontainers.JobTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EMR Containers Job Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRContainersConn()
+
+		output, err := tfemrcontainers.FindJobTemplateByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckJobTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRContainersConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_emrcontainers_job_template" {
+				continue
+			}
+
+			_, err := tfemrcontainers.FindJobTemplateByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EMR Containers Job Template %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccJobTemplateConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "elasticmapreduce.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccJobTemplateConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccJobTemplateConfig_base(rName), fmt.Sprintf(`
+resource "aws_emrcontainers_job_template" "test" {
+  job_template_data {
+    execution_role_arn = aws_iam_role.test.arn
+    release_label      = "emr-6.10.0-latest"
+
+    job_driver {
+      spark_submit_job_driver {
+        entry_point = "default"
+      }
+    }
+  }
+
+  name = %[1]q
+}
+`, rName))
+}
+
+func testAccJobTemplateConfig_full(rName string) string {
+	return acctest.ConfigCompose(testAccJobTemplateConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_emrcontainers_job_template" "test" {
+  job_template_data {
+    execution_role_arn = aws_iam_role.test.arn
+    release_label      = "emr-6.10.0-latest"
+
+    configuration_overrides {
+      application_configuration {
+        classification = "spark-defaults"
+
+        properties = {
+          "spark.dynamicAllocation.enabled" = "false"
+        }
+      }
+
+      monitoring_configuration {
+        cloud_watch_monitoring_configuration {
+          log_group_name         = aws_cloudwatch_log_group.test.name
+          log_stream_name_prefix = "test"
+        }
+
+        persistent_app_ui = "ENABLED"
+      }
+    }
+
+    job_driver {
+      spark_submit_job_driver {
+        entry_point             = "local:///usr/lib/spark/examples/src/main/python/pi.py"
+        entry_point_arguments   = ["10"]
+        spark_submit_parameters = "--conf spark.executor.instances=$${ExecutorInstances}"
+      }
+    }
+
+    job_tags = {
+      Name = %[1]q
+    }
+
+    parameter_configuration {
+      default_value = "2"
+      name          = "ExecutorInstances"
+      type          = "NUMBER"
+    }
+  }
+
+  name = %[1]q
+}
+`, rName))
+}
+
+func testAccJobTemplateConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccJobTemplateConfig_base(rName), fmt.Sprintf(`
+resource "aws_emrcontainers_job_template" "test" {
+  job_template_data {
+    execution_role_arn = aws_iam_role.test.arn
+    release_label      = "emr-6.10.0-latest"
+
+    job_driver {
+      spark_submit_job_driver {
+        entry_point = "default"
+      }
+    }
+  }
+
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccJobTemplateConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccJobTemplateConfig_base(rName), fmt.Sprintf(`
+resource "aws_emrcontainers_job_template" "test" {
+  job_template_data {
+    execution_role_arn = aws_iam_role.test.arn
+    release_label      = "emr-6.10.0-latest"
+
+    job_driver {
+      spark_submit_job_driver {
+        entry_point = "default"
+      }
+    }
+  }
+
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,78 @@
+package gamelift
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourceComputeAuthToken() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceComputeAuthTokenRead,
+
+		Schema: map[string]*schema.Schema{
+			"auth_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"compute_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compute_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"expiration_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fleet_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fleet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeAuthTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn()
+
+	fleetID := d.Get("fleet_id").(string)
+	computeName := d.Get("compute_name").(string)
+	input := &gamelift.GetComputeAuthTokenInput{
+		ComputeName: aws.String(computeName),
+		FleetId:     aws.String(fleetID),
+	}
+
+	output, err := conn.GetComputeAuthTokenWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Compute (%s/%s) auth token: %s", fleetID, computeName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", fleetID, computeName))
+	d.Set("auth_token", output.AuthToken)
+	d.Set("compute_arn", output.ComputeArn)
+	if v := output.ExpirationTimestamp; v != nil {
+		d.Set("expiration_timestamp", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("expiration_timestamp", nil)
+	}
+	d.Set("fleet_arn", output.FleetArn)
+
+	return diags
+}
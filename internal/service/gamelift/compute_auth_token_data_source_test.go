@@ -0,0 +1,57 @@
+package gamelift_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// Auth tokens are only issued for computes registered with an Anywhere fleet.
+func TestAccGameLiftComputeAuthTokenDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	fleetID := os.Getenv("GAMELIFT_ANYWHERE_FLEET_ID")
+	if fleetID == "" {
+		t.Skip("Environment variable GAMELIFT_ANYWHERE_FLEET_ID is not set")
+	}
+	computeName := os.Getenv("GAMELIFT_ANYWHERE_COMPUTE_NAME")
+	if computeName == "" {
+		t.Skip("Environment variable GAMELIFT_ANYWHERE_COMPUTE_NAME is not set")
+	}
+	dataSourceName := "data.aws_gamelift_compute_auth_token.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, gamelift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeAuthTokenDataSourceConfig_basic(fleetID, computeName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "auth_token"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "compute_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "compute_name", computeName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "expiration_timestamp"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "fleet_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "fleet_id", fleetID),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeAuthTokenDataSourceConfig_basic(fleetID, computeName string) string {
+	return fmt.Sprintf(`
+data "aws_gamelift_compute_auth_token" "test" {
+  fleet_id     = %[1]q
+  compute_name = %[2]q
+}
+`, fleetID, computeName)
+}
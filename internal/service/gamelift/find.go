@@ -119,3 +119,29 @@ func FindScriptByID(ctx context.Context, conn *gamelift.GameLift, id string) (*g
 
 	return output.Script, nil
 }
+
+func FindFleetLocationCapacity(ctx context.Context, conn *gamelift.GameLift, fleetID, location string) (*gamelift.FleetCapacity, error) {
+	input := &gamelift.DescribeFleetLocationCapacityInput{
+		FleetId:  aws.String(fleetID),
+		Location: aws.String(location),
+	}
+
+	output, err := conn.DescribeFleetLocationCapacityWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.FleetCapacity == nil || output.FleetCapacity.InstanceCounts == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.FleetCapacity, nil
+}
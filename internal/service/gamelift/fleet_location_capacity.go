@@ -0,0 +1,164 @@
+package gamelift
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceFleetLocationCapacity() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFleetLocationCapacityPut,
+		ReadWithoutTimeout:   resourceFleetLocationCapacityRead,
+		UpdateWithoutTimeout: resourceFleetLocationCapacityPut,
+		DeleteWithoutTimeout: resourceFleetLocationCapacityDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"desired_instances": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"fleet_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fleet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"location": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"max_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"min_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func resourceFleetLocationCapacityPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn()
+
+	fleetID, location := d.Get("fleet_id").(string), d.Get("location").(string)
+	id := FleetLocationCapacityCreateResourceID(fleetID, location)
+	input := &gamelift.UpdateFleetCapacityInput{
+		FleetId:  aws.String(fleetID),
+		Location: aws.String(location),
+	}
+
+	// Only send the limits that are configured so that unset values keep their current setting.
+	// The raw configuration is checked so that an explicit 0 is still sent.
+	rawConfig := d.GetRawConfig()
+
+	if v := rawConfig.GetAttr("desired_instances"); v.IsKnown() && !v.IsNull() {
+		input.DesiredInstances = aws.Int64(int64(d.Get("desired_instances").(int)))
+	}
+
+	if v := rawConfig.GetAttr("max_size"); v.IsKnown() && !v.IsNull() {
+		input.MaxSize = aws.Int64(int64(d.Get("max_size").(int)))
+	}
+
+	if v := rawConfig.GetAttr("min_size"); v.IsKnown() && !v.IsNull() {
+		input.MinSize = aws.Int64(int64(d.Get("min_size").(int)))
+	}
+
+	_, err := conn.UpdateFleetCapacityWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating GameLift Fleet Location Capacity (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceFleetLocationCapacityRead(ctx, d, meta)...)
+}
+
+func resourceFleetLocationCapacityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn()
+
+	fleetID, location, err := FleetLocationCapacityParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	capacity, err := FindFleetLocationCapacity(ctx, conn, fleetID, location)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GameLift Fleet Location Capacity (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Fleet Location Capacity (%s): %s", d.Id(), err)
+	}
+
+	d.Set("desired_instances", capacity.InstanceCounts.DESIRED)
+	d.Set("fleet_arn", capacity.FleetArn)
+	d.Set("fleet_id", capacity.FleetId)
+	d.Set("location", capacity.Location)
+	d.Set("max_size", capacity.InstanceCounts.MAXIMUM)
+	d.Set("min_size", capacity.InstanceCounts.MINIMUM)
+
+	return diags
+}
+
+func resourceFleetLocationCapacityDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Capacity is a property of the fleet location and cannot be removed.
+	// Leave the current instance counts in place.
+	log.Printf("[WARN] GameLift Fleet Location Capacity (%s) removed from state only; capacity is unchanged", d.Id())
+
+	return nil
+}
+
+const fleetLocationCapacityResourceIDSeparator = ","
+
+func FleetLocationCapacityCreateResourceID(fleetID, location string) string {
+	parts := []string{fleetID, location}
+	id := strings.Join(parts, fleetLocationCapacityResourceIDSeparator)
+
+	return id
+}
+
+func FleetLocationCapacityParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, fleetLocationCapacityResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FLEET-ID%[2]sLOCATION", id, fleetLocationCapacityResourceIDSeparator)
+}
@@ -0,0 +1,192 @@
+package gamelift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgamelift "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestFleetLocationCapacityParseResourceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName         string
+		Input            string
+		ExpectedFleetID  string
+		ExpectedLocation string
+		Error            bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Error:    true,
+		},
+		{
+			TestName: "Invalid ID",
+			Input:    "fleet-12345678,",
+			Error:    true,
+		},
+		{
+			TestName: "Invalid ID with more than 1 separator",
+			Input:    "fleet-12345678,us-west-2,us-east-1",
+			Error:    true,
+		},
+		{
+			TestName:         "Valid ID",
+			Input:            "fleet-12345678,us-west-2",
+			ExpectedFleetID:  "fleet-12345678",
+			ExpectedLocation: "us-west-2",
+			Error:            false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			gotFleetID, gotLocation, err := tfgamelift.FleetLocationCapacityParseResourceID(testCase.Input)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (FleetID: %s, Location: %s) and no error, expected error", gotFleetID, gotLocation)
+			}
+
+			if gotFleetID != testCase.ExpectedFleetID {
+				t.Errorf("got %s, expected %s", gotFleetID, testCase.ExpectedFleetID)
+			}
+
+			if gotLocation != testCase.ExpectedLocation {
+				t.Errorf("got %s, expected %s", gotLocation, testCase.ExpectedLocation)
+			}
+		})
+	}
+}
+
+func TestAccGameLiftFleetLocationCapacity_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	region := acctest.Region()
+	g, err := testAccSampleGame(region)
+
+	if tfresource.NotFound(err) {
+		t.Skip(err)
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loc := g.Location
+	bucketName := *loc.Bucket
+	roleArn := *loc.RoleArn
+	key := *loc.Key
+
+	launchPath := g.LaunchPath
+	params := g.Parameters(33435)
+	resourceName := "aws_gamelift_fleet_location_capacity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, gamelift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetLocationCapacityConfig_basic(rName, launchPath, params, bucketName, key, roleArn, 0, 0, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetLocationCapacity(ctx, resourceName, 0, 0, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_arn", "aws_gamelift_fleet.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_id", "aws_gamelift_fleet.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "location", region),
+					resource.TestCheckResourceAttr(resourceName, "desired_instances", "0"),
+					resource.TestCheckResourceAttr(resourceName, "min_size", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_size", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFleetLocationCapacityConfig_basic(rName, launchPath, params, bucketName, key, roleArn, 1, 1, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetLocationCapacity(ctx, resourceName, 1, 1, 3),
+					resource.TestCheckResourceAttr(resourceName, "desired_instances", "1"),
+					resource.TestCheckResourceAttr(resourceName, "min_size", "1"),
+					resource.TestCheckResourceAttr(resourceName, "max_size", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFleetLocationCapacity(ctx context.Context, n string, desired, min, max int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
+
+		capacity, err := tfgamelift.FindFleetLocationCapacity(ctx, conn, rs.Primary.Attributes["fleet_id"], rs.Primary.Attributes["location"])
+
+		if err != nil {
+			return err
+		}
+
+		counts := capacity.InstanceCounts
+		if got := *counts.DESIRED; got != desired {
+			return fmt.Errorf("GameLift Fleet Location Capacity (%s) desired instances: got %d, expected %d", rs.Primary.ID, got, desired)
+		}
+
+		if got := *counts.MINIMUM; got != min {
+			return fmt.Errorf("GameLift Fleet Location Capacity (%s) minimum size: got %d, expected %d", rs.Primary.ID, got, min)
+		}
+
+		if got := *counts.MAXIMUM; got != max {
+			return fmt.Errorf("GameLift Fleet Location Capacity (%s) maximum size: got %d, expected %d", rs.Primary.ID, got, max)
+		}
+
+		return nil
+	}
+}
+
+func testAccFleetLocationCapacityConfig_basic(rName, launchPath, params, bucketName, key, roleArn string, desired, min, max int) string {
+	return acctest.ConfigCompose(
+		testAccFleetConfig_basic(rName, launchPath, params, bucketName, key, roleArn),
+		fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_gamelift_fleet_location_capacity" "test" {
+  fleet_id          = aws_gamelift_fleet.test.id
+  location          = data.aws_region.current.name
+  desired_instances = %[1]d
+  min_size          = %[2]d
+  max_size          = %[3]d
+}
+`, desired, min, max))
+}
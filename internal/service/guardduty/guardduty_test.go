@@ -52,6 +52,7 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			"inviteOnUpdate":     testAccMember_invite_onUpdate,
 			"inviteDisassociate": testAccMember_invite_disassociate,
 			"invitationMessage":  testAccMember_invitationMessage,
+			"datasource_basic":   testAccMemberDetectorsDataSource_basic,
 		},
 		"PublishingDestination": {
 			"basic":      testAccPublishingDestination_basic,
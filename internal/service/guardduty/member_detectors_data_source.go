@@ -0,0 +1,215 @@
+package guardduty
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// getMemberDetectorsMaxAccountIDs is the maximum number of account IDs accepted by a single GetMemberDetectors call.
+const getMemberDetectorsMaxAccountIDs = 50
+
+func DataSourceMemberDetectors() *schema.Resource {
+	enableSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enable": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMemberDetectorsRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"datasources": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kubernetes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"audit_logs": enableSchema(),
+											},
+										},
+									},
+									"malware_protection": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"scan_ec2_instance_with_findings": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"ebs_volumes": enableSchema(),
+														},
+													},
+												},
+											},
+										},
+									},
+									"s3_logs": enableSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"unprocessed_accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMemberDetectorsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GuardDutyConn()
+
+	detectorID := d.Get("detector_id").(string)
+
+	var accountIDs []*string
+	if v, ok := d.GetOk("account_ids"); ok && v.(*schema.Set).Len() > 0 {
+		accountIDs = flex.ExpandStringSet(v.(*schema.Set))
+	} else {
+		input := &guardduty.ListMembersInput{
+			DetectorId:     aws.String(detectorID),
+			OnlyAssociated: aws.String("true"),
+		}
+
+		err := conn.ListMembersPagesWithContext(ctx, input, func(page *guardduty.ListMembersOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Members {
+				if v != nil {
+					accountIDs = append(accountIDs, v.AccountId)
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing GuardDuty Members (%s): %s", detectorID, err)
+		}
+	}
+
+	var members, unprocessedAccounts []interface{}
+
+	for len(accountIDs) > 0 {
+		n := len(accountIDs)
+		if n > getMemberDetectorsMaxAccountIDs {
+			n = getMemberDetectorsMaxAccountIDs
+		}
+
+		input := &guardduty.GetMemberDetectorsInput{
+			AccountIds: accountIDs[:n],
+			DetectorId: aws.String(detectorID),
+		}
+		accountIDs = accountIDs[n:]
+
+		output, err := conn.GetMemberDetectorsWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading GuardDuty Member Detectors (%s): %s", detectorID, err)
+		}
+
+		for _, v := range output.MemberDataSourceConfigurations {
+			if v == nil {
+				continue
+			}
+
+			tfMap := map[string]interface{}{
+				"account_id": aws.StringValue(v.AccountId),
+			}
+
+			if v.DataSources != nil {
+				tfMap["datasources"] = []interface{}{flattenDataSourceConfigurationsResult(v.DataSources)}
+			}
+
+			members = append(members, tfMap)
+		}
+
+		for _, v := range output.UnprocessedAccounts {
+			if v == nil {
+				continue
+			}
+
+			unprocessedAccounts = append(unprocessedAccounts, map[string]interface{}{
+				"account_id": aws.StringValue(v.AccountId),
+				"result":     aws.StringValue(v.Result),
+			})
+		}
+	}
+
+	accountIDSet := make([]string, 0, len(members)+len(unprocessedAccounts))
+	for _, v := range members {
+		accountIDSet = append(accountIDSet, v.(map[string]interface{})["account_id"].(string))
+	}
+	for _, v := range unprocessedAccounts {
+		accountIDSet = append(accountIDSet, v.(map[string]interface{})["account_id"].(string))
+	}
+
+	d.SetId(detectorID)
+	d.Set("account_ids", accountIDSet)
+	if err := d.Set("members", members); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting members: %s", err)
+	}
+	if err := d.Set("unprocessed_accounts", unprocessedAccounts); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting unprocessed_accounts: %s", err)
+	}
+
+	return diags
+}
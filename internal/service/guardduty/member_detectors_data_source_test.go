@@ -0,0 +1,42 @@
+package guardduty_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccMemberDetectorsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_guardduty_member_detectors.test"
+	accountID, email := testAccMemberFromEnv(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberDetectorsDataSourceConfig_basic(accountID, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "aws_guardduty_detector.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "account_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "account_ids.*", accountID),
+				),
+			},
+		},
+	})
+}
+
+func testAccMemberDetectorsDataSourceConfig_basic(accountID, email string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "aws_guardduty_member_detectors" "test" {
+  detector_id = aws_guardduty_detector.test.id
+  account_ids = [aws_guardduty_member.test.account_id]
+}
+`, testAccMemberConfig_invite(accountID, email, true))
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,6 +19,12 @@ import (
 
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
 
+const (
+	// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html#reference_iam-quotas-entity-length.
+	managedPolicySizeLimit          = 6144
+	managedPolicySizeWarningPercent = 90
+)
+
 func DataSourcePolicyDocument() *schema.Resource {
 	setOfString := &schema.Schema{
 		Type:     schema.TypeSet,
@@ -35,6 +42,14 @@ func DataSourcePolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"minified_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"minified_json_length": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"override_json": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -79,8 +94,9 @@ func DataSourcePolicyDocument() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"test": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validPolicyConditionOperator,
 									},
 									"values": {
 										Type:     schema.TypeList,
@@ -292,7 +308,23 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	}
 	jsonString := string(jsonDoc)
 
+	minifiedJSONDoc, err := json.Marshal(mergedDoc)
+	if err != nil {
+		// should never happen if the above code is correct
+		return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: formatting minified JSON: %s", err)
+	}
+	minifiedJSONString := string(minifiedJSONDoc)
+	minifiedJSONLength := utf8.RuneCountInString(minifiedJSONString)
+
+	if minifiedJSONLength > managedPolicySizeLimit {
+		diags = sdkdiag.AppendWarningf(diags, "IAM Policy Document minified length (%d characters) exceeds the managed policy limit of %d characters", minifiedJSONLength, managedPolicySizeLimit)
+	} else if minifiedJSONLength*100 >= managedPolicySizeLimit*managedPolicySizeWarningPercent {
+		diags = sdkdiag.AppendWarningf(diags, "IAM Policy Document minified length (%d characters) is approaching the managed policy limit of %d characters", minifiedJSONLength, managedPolicySizeLimit)
+	}
+
 	d.Set("json", jsonString)
+	d.Set("minified_json", minifiedJSONString)
+	d.Set("minified_json_length", minifiedJSONLength)
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
 	return diags
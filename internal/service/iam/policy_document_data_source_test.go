@@ -26,6 +26,10 @@ func TestAccIAMPolicyDocumentDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test", "json",
 						testAccPolicyDocumentExpectedJSON(),
 					),
+					acctest.CheckResourceAttrEquivalentJSON("data.aws_iam_policy_document.test", "minified_json",
+						testAccPolicyDocumentExpectedJSON(),
+					),
+					resource.TestCheckResourceAttrSet("data.aws_iam_policy_document.test", "minified_json_length"),
 				),
 			},
 		},
@@ -61,3 +61,66 @@ var validRolePolicyRole = validation.All(
 		return
 	},
 )
+
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html.
+var policyConditionOperators = []string{
+	"ArnEquals",
+	"ArnLike",
+	"ArnNotEquals",
+	"ArnNotLike",
+	"BinaryEquals",
+	"Bool",
+	"DateEquals",
+	"DateGreaterThan",
+	"DateGreaterThanEquals",
+	"DateLessThan",
+	"DateLessThanEquals",
+	"DateNotEquals",
+	"IpAddress",
+	"NotIpAddress",
+	"Null",
+	"NumericEquals",
+	"NumericGreaterThan",
+	"NumericGreaterThanEquals",
+	"NumericLessThan",
+	"NumericLessThanEquals",
+	"NumericNotEquals",
+	"StringEquals",
+	"StringEqualsIgnoreCase",
+	"StringLike",
+	"StringNotEquals",
+	"StringNotEqualsIgnoreCase",
+	"StringNotLike",
+}
+
+// validPolicyConditionOperator validates an IAM policy condition operator,
+// including any ForAllValues: or ForAnyValue: set operator prefix and IfExists suffix.
+func validPolicyConditionOperator(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+	operator := value
+
+	for _, prefix := range []string{"ForAllValues:", "ForAnyValue:"} {
+		if len(operator) > len(prefix) && strings.EqualFold(operator[:len(prefix)], prefix) {
+			operator = operator[len(prefix):]
+			break
+		}
+	}
+
+	if suffix := "IfExists"; len(operator) > len(suffix) && strings.EqualFold(operator[len(operator)-len(suffix):], suffix) {
+		operator = operator[:len(operator)-len(suffix)]
+
+		if strings.EqualFold(operator, "Null") {
+			es = append(es, fmt.Errorf("%q (%s): the Null condition operator does not support the IfExists suffix", k, value))
+			return
+		}
+	}
+
+	for _, valid := range policyConditionOperators {
+		if strings.EqualFold(operator, valid) {
+			return
+		}
+	}
+
+	es = append(es, fmt.Errorf("%q (%s) is not a valid IAM policy condition operator", k, value))
+	return
+}
@@ -126,3 +126,59 @@ func TestValidRolePolicyRoleName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidPolicyConditionOperator(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value: "StringEquals",
+		},
+		{
+			Value: "stringlike",
+		},
+		{
+			Value: "ArnLikeIfExists",
+		},
+		{
+			Value: "ForAllValues:StringEquals",
+		},
+		{
+			Value: "ForAnyValue:StringLikeIfExists",
+		},
+		{
+			Value: "Null",
+		},
+		{
+			Value:    "NullIfExists",
+			ErrCount: 1,
+		},
+		{
+			Value:    "StringEqual",
+			ErrCount: 1,
+		},
+		{
+			Value:    "ForAllValues:",
+			ErrCount: 1,
+		},
+		{
+			Value:    "IfExists",
+			ErrCount: 1,
+		},
+		{
+			Value:    "ForEachValue:StringEquals",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validPolicyConditionOperator(tc.Value, "test")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d policy condition operator validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package identitystore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameGroupMembershipsExclusive = "GroupMembershipsExclusive"
+)
+
+func ResourceGroupMembershipsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupMembershipsExclusiveCreate,
+		ReadWithoutTimeout:   resourceGroupMembershipsExclusiveRead,
+		UpdateWithoutTimeout: resourceGroupMembershipsExclusiveUpdate,
+		DeleteWithoutTimeout: resourceGroupMembershipsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+
+			"identity_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 36),
+			},
+
+			"member_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 47),
+				},
+			},
+		},
+	}
+}
+
+func resourceGroupMembershipsExclusiveCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreClient()
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	groupID := d.Get("group_id").(string)
+	id := fmt.Sprintf("%s/%s", identityStoreID, groupID)
+
+	if err := syncGroupMemberships(ctx, conn, identityStoreID, groupID, flex.ExpandStringValueSet(d.Get("member_ids").(*schema.Set))); err != nil {
+		return create.DiagError(names.IdentityStore, create.ErrActionCreating, ResNameGroupMembershipsExclusive, id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceGroupMembershipsExclusiveRead(ctx, d, meta)
+}
+
+func resourceGroupMembershipsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreClient()
+
+	identityStoreID, groupID, err := resourceGroupParseID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.IdentityStore, create.ErrActionReading, ResNameGroupMembershipsExclusive, d.Id(), err)
+	}
+
+	memberships, err := findGroupMembershipsByGroupID(ctx, conn, identityStoreID, groupID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IdentityStore GroupMembershipsExclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.IdentityStore, create.ErrActionReading, ResNameGroupMembershipsExclusive, d.Id(), err)
+	}
+
+	memberIDs := make([]string, 0, len(memberships))
+	for memberID := range memberships {
+		memberIDs = append(memberIDs, memberID)
+	}
+
+	d.Set("group_id", groupID)
+	d.Set("identity_store_id", identityStoreID)
+	d.Set("member_ids", memberIDs)
+
+	return nil
+}
+
+func resourceGroupMembershipsExclusiveUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreClient()
+
+	if d.HasChange("member_ids") {
+		if err := syncGroupMemberships(ctx, conn, d.Get("identity_store_id").(string), d.Get("group_id").(string), flex.ExpandStringValueSet(d.Get("member_ids").(*schema.Set))); err != nil {
+			return create.DiagError(names.IdentityStore, create.ErrActionUpdating, ResNameGroupMembershipsExclusive, d.Id(), err)
+		}
+	}
+
+	return resourceGroupMembershipsExclusiveRead(ctx, d, meta)
+}
+
+func resourceGroupMembershipsExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreClient()
+
+	log.Printf("[INFO] Deleting IdentityStore GroupMembershipsExclusive %s", d.Id())
+
+	err := syncGroupMemberships(ctx, conn, d.Get("identity_store_id").(string), d.Get("group_id").(string), nil)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.IdentityStore, create.ErrActionDeleting, ResNameGroupMembershipsExclusive, d.Id(), err)
+	}
+
+	return nil
+}
+
+// syncGroupMemberships creates and deletes group memberships so that the group's members are exactly memberIDs.
+func syncGroupMemberships(ctx context.Context, conn *identitystore.Client, identityStoreID, groupID string, memberIDs []string) error {
+	memberships, err := findGroupMembershipsByGroupID(ctx, conn, identityStoreID, groupID)
+
+	if err != nil {
+		return err
+	}
+
+	want := make(map[string]struct{}, len(memberIDs))
+	for _, memberID := range memberIDs {
+		want[memberID] = struct{}{}
+	}
+
+	var errs *multierror.Error
+
+	for memberID, membershipID := range memberships {
+		if _, ok := want[memberID]; ok {
+			continue
+		}
+
+		_, err := conn.DeleteGroupMembership(ctx, &identitystore.DeleteGroupMembershipInput{
+			IdentityStoreId: aws.String(identityStoreID),
+			MembershipId:    aws.String(membershipID),
+		})
+
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			continue
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("deleting membership (%s) for member (%s): %w", membershipID, memberID, err))
+		}
+	}
+
+	for memberID := range want {
+		if _, ok := memberships[memberID]; ok {
+			continue
+		}
+
+		_, err := conn.CreateGroupMembership(ctx, &identitystore.CreateGroupMembershipInput{
+			GroupId:         aws.String(groupID),
+			IdentityStoreId: aws.String(identityStoreID),
+			MemberId:        &types.MemberIdMemberUserId{Value: memberID},
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("creating membership for member (%s): %w", memberID, err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// findGroupMembershipsByGroupID returns a map of member (user) ID to membership ID for the group's memberships.
+func findGroupMembershipsByGroupID(ctx context.Context, conn *identitystore.Client, identityStoreID, groupID string) (map[string]string, error) {
+	in := &identitystore.ListGroupMembershipsInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+
+	memberships := make(map[string]string)
+	paginator := identitystore.NewListGroupMembershipsPaginator(conn, in)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			var e *types.ResourceNotFoundException
+			if errors.As(err, &e) {
+				return nil, &resource.NotFoundError{
+					LastError:   err,
+					LastRequest: in,
+				}
+			}
+
+			return nil, err
+		}
+
+		for _, v := range page.GroupMemberships {
+			memberID, err := getMemberIdMemberUserId(v.MemberId)
+
+			if err != nil {
+				return nil, err
+			}
+
+			memberships[aws.ToString(memberID)] = aws.ToString(v.MembershipId)
+		}
+	}
+
+	return memberships, nil
+}
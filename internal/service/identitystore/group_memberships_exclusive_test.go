@@ -0,0 +1,249 @@
+package identitystore_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfidentitystore "github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIdentityStoreGroupMembershipsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	groupResourceName := "aws_identitystore_group.test"
+	resourceName := "aws_identitystore_group_memberships_exclusive.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.IdentityStoreEndpointID, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupMembershipsExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsExclusiveConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", groupResourceName, "group_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity_store_id"),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_ids.*", "aws_identitystore_user.test.0", "user_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_ids.*", "aws_identitystore_user.test.1", "user_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGroupMembershipsExclusiveConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_ids.*", "aws_identitystore_user.test.0", "user_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreGroupMembershipsExclusive_outOfBand(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_identitystore_group_memberships_exclusive.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.IdentityStoreEndpointID, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupMembershipsExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsExclusiveConfig_outOfBand(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExclusiveCount(ctx, resourceName, 1),
+					testAccCheckGroupMembershipsExclusiveAddMember(ctx, resourceName, "aws_identitystore_user.out_of_band"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccGroupMembershipsExclusiveConfig_outOfBand(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGroupMembershipsExclusiveDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreClient()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_identitystore_group_memberships_exclusive" {
+				continue
+			}
+
+			out, err := conn.ListGroupMemberships(ctx, &identitystore.ListGroupMembershipsInput{
+				GroupId:         aws.String(rs.Primary.Attributes["group_id"]),
+				IdentityStoreId: aws.String(rs.Primary.Attributes["identity_store_id"]),
+			})
+
+			if err != nil {
+				var nfe *types.ResourceNotFoundException
+				if errors.As(err, &nfe) {
+					continue
+				}
+				return err
+			}
+
+			if len(out.GroupMemberships) == 0 {
+				continue
+			}
+
+			return create.Error(names.IdentityStore, create.ErrActionCheckingDestroyed, tfidentitystore.ResNameGroupMembershipsExclusive, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGroupMembershipsExclusiveCount(ctx context.Context, name string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMembershipsExclusive, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMembershipsExclusive, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreClient()
+
+		out, err := conn.ListGroupMemberships(ctx, &identitystore.ListGroupMembershipsInput{
+			GroupId:         aws.String(rs.Primary.Attributes["group_id"]),
+			IdentityStoreId: aws.String(rs.Primary.Attributes["identity_store_id"]),
+		})
+
+		if err != nil {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMembershipsExclusive, rs.Primary.ID, err)
+		}
+
+		if got := len(out.GroupMemberships); got != want {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMembershipsExclusive, rs.Primary.ID, fmt.Errorf("expected %d memberships, got %d", want, got))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGroupMembershipsExclusiveAddMember(ctx context.Context, name, userResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		user, ok := s.RootModule().Resources[userResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", userResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreClient()
+
+		_, err := conn.CreateGroupMembership(ctx, &identitystore.CreateGroupMembershipInput{
+			GroupId:         aws.String(rs.Primary.Attributes["group_id"]),
+			IdentityStoreId: aws.String(rs.Primary.Attributes["identity_store_id"]),
+			MemberId:        &types.MemberIdMemberUserId{Value: user.Primary.Attributes["user_id"]},
+		})
+
+		return err
+	}
+}
+
+func testAccGroupMembershipsExclusiveConfig_base(rName string, userCount int) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  display_name      = %[1]q
+  description       = "Acceptance Test"
+}
+
+resource "aws_identitystore_user" "test" {
+  count = %[2]d
+
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = "%[1]s-${count.index}"
+
+  name {
+    family_name = "Doe"
+    given_name  = "John"
+  }
+}
+`, rName, userCount)
+}
+
+func testAccGroupMembershipsExclusiveConfig_basic(rName string, memberCount int) string {
+	members := make([]string, memberCount)
+	for i := range members {
+		members[i] = fmt.Sprintf("aws_identitystore_user.test[%d].user_id", i)
+	}
+
+	return acctest.ConfigCompose(testAccGroupMembershipsExclusiveConfig_base(rName, 2), fmt.Sprintf(`
+resource "aws_identitystore_group_memberships_exclusive" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  group_id          = aws_identitystore_group.test.group_id
+  member_ids        = [%[1]s]
+}
+`, strings.Join(members, ", ")))
+}
+
+func testAccGroupMembershipsExclusiveConfig_outOfBand(rName string) string {
+	return acctest.ConfigCompose(testAccGroupMembershipsExclusiveConfig_base(rName, 1), fmt.Sprintf(`
+resource "aws_identitystore_user" "out_of_band" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = "%[1]s-oob"
+
+  name {
+    family_name = "Doe"
+    given_name  = "Jane"
+  }
+}
+
+resource "aws_identitystore_group_memberships_exclusive" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  group_id          = aws_identitystore_group.test.group_id
+  member_ids        = [aws_identitystore_user.test[0].user_id]
+}
+`, rName))
+}
@@ -1490,13 +1490,29 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		if updateApplication {
-			input.CurrentApplicationVersionId = aws.Int64(currentApplicationVersionId)
+			// Running applications can be modified by the service while an update is in flight.
+			// Prefer the conditional token, refreshed on each attempt, over the application version ID.
+			_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+				application, err := FindApplicationDetailByName(ctx, conn, applicationName)
+
+				if err != nil {
+					return nil, err
+				}
 
-			log.Printf("[DEBUG] Updating Kinesis Analytics v2 Application (%s): %s", d.Id(), input)
+				if v := application.ConditionalToken; v != nil {
+					input.ConditionalToken = v
+					input.CurrentApplicationVersionId = nil
+				} else {
+					input.ConditionalToken = nil
+					input.CurrentApplicationVersionId = aws.Int64(currentApplicationVersionId)
+				}
 
-			_, err := waitIAMPropagation(ctx, func() (interface{}, error) {
-				return conn.UpdateApplicationWithContext(ctx, input)
-			})
+				log.Printf("[DEBUG] Updating Kinesis Analytics v2 Application (%s): %s", d.Id(), input)
+
+				return waitIAMPropagation(ctx, func() (interface{}, error) {
+					return conn.UpdateApplicationWithContext(ctx, input)
+				})
+			}, kinesisanalyticsv2.ErrCodeConcurrentModificationException)
 
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
@@ -522,7 +522,14 @@ func resourceEventSourceMappingRead(ctx context.Context, d *schema.ResourceData,
 	}
 	d.Set("event_source_arn", eventSourceMappingConfiguration.EventSourceArn)
 	if v := eventSourceMappingConfiguration.FilterCriteria; v != nil {
-		if err := d.Set("filter_criteria", []interface{}{flattenFilterCriteria(v)}); err != nil {
+		tfMap := flattenFilterCriteria(v)
+
+		// Lambda normalizes filter patterns, so keep any equivalent pattern already in state.
+		if tfList, ok := tfMap["filter"].([]interface{}); ok {
+			tfMap["filter"] = filtersWithEquivalentPatterns(tfList, d.Get("filter_criteria").([]interface{}))
+		}
+
+		if err := d.Set("filter_criteria", []interface{}{tfMap}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting filter criteria: %s", err)
 		}
 	} else {
@@ -1076,6 +1083,50 @@ func flattenFilter(apiObject *lambda.Filter) map[string]interface{} {
 	return tfMap
 }
 
+// filtersWithEquivalentPatterns replaces each filter pattern in tfList with a JSON-equivalent pattern from the existing filter_criteria, if any.
+func filtersWithEquivalentPatterns(tfList []interface{}, filterCriteria []interface{}) []interface{} {
+	if len(filterCriteria) == 0 || filterCriteria[0] == nil {
+		return tfList
+	}
+
+	filters, ok := filterCriteria[0].(map[string]interface{})["filter"].(*schema.Set)
+
+	if !ok {
+		return tfList
+	}
+
+	var patterns []string
+
+	for _, tfMapRaw := range filters.List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			patterns = append(patterns, tfMap["pattern"].(string))
+		}
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		v, ok := tfMap["pattern"].(string)
+
+		if !ok {
+			continue
+		}
+
+		for _, pattern := range patterns {
+			if pattern != v && verify.JSONStringsEqual(pattern, v) {
+				tfMap["pattern"] = pattern
+				break
+			}
+		}
+	}
+
+	return tfList
+}
+
 func expandScalingConfig(tfMap map[string]interface{}) *lambda.ScalingConfig {
 	if tfMap == nil {
 		return nil
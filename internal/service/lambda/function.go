@@ -498,7 +498,7 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
 
-	_, err := retryFunctionOp(ctx, func() (interface{}, error) {
+	outputRaw, err := retryFunctionOp(ctx, func() (interface{}, error) {
 		return conn.CreateFunctionWithContext(ctx, input)
 	})
 
@@ -520,6 +520,13 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): waiting for completion: %s", d.Id(), err)
 	}
 
+	// Published versions of SnapStart functions stay pending until the snapshot is optimized.
+	if output, ok := outputRaw.(*lambda.FunctionConfiguration); ok && d.Get("publish").(bool) {
+		if _, err := waitFunctionPublishedVersionActive(ctx, conn, d.Id(), aws.StringValue(output.Version), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): waiting for version (%s) to become active: %s", d.Id(), aws.StringValue(output.Version), err)
+		}
+	}
+
 	if v, ok := d.Get("reserved_concurrent_executions").(int); ok && v >= 0 {
 		_, err := conn.PutFunctionConcurrencyWithContext(ctx, &lambda.PutFunctionConcurrencyInput{
 			FunctionName:                 aws.String(d.Id()),
@@ -959,6 +966,11 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "publishing Lambda Function (%s) version: waiting for completion: %s", d.Id(), err)
 		}
+
+		// Published versions of SnapStart functions stay pending until the snapshot is optimized.
+		if _, err := waitFunctionPublishedVersionActive(ctx, conn, d.Id(), aws.StringValue(output.Version), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "publishing Lambda Function (%s) version: waiting for version (%s) to become active: %s", d.Id(), aws.StringValue(output.Version), err)
+		}
 	}
 
 	return append(diags, resourceFunctionRead(ctx, d, meta)...)
@@ -0,0 +1,53 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusFunctionVersionState(ctx context.Context, conn *lambda.Lambda, name, version string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFunction(ctx, conn, &lambda.GetFunctionInput{
+			FunctionName: aws.String(name),
+			Qualifier:    aws.String(version),
+		})
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output.Configuration, aws.StringValue(output.Configuration.State), nil
+	}
+}
+
+// waitFunctionPublishedVersionActive waits for a published version to become active.
+// Published versions of SnapStart functions stay pending until the snapshot is optimized.
+func waitFunctionPublishedVersionActive(ctx context.Context, conn *lambda.Lambda, name, version string, timeout time.Duration) (*lambda.FunctionConfiguration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lambda.StatePending},
+		Target:  []string{lambda.StateActive},
+		Refresh: statusFunctionVersionState(ctx, conn, name, version),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lambda.FunctionConfiguration); ok {
+		tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.StateReasonCode), aws.StringValue(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
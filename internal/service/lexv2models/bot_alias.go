@@ -0,0 +1,819 @@
+package lexv2models
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceBotAlias() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBotAliasCreate,
+		ReadWithoutTimeout:   resourceBotAliasRead,
+		UpdateWithoutTimeout: resourceBotAliasUpdate,
+		DeleteWithoutTimeout: resourceBotAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bot_alias_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bot_alias_locale_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code_hook_specification": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"lambda_code_hook": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"code_hook_interface_version": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 5),
+												},
+												"lambda_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"locale_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"conversation_log_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"audio_log_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"destination": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_bucket": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"kms_key_arn": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: verify.ValidARN,
+															},
+															"log_prefix": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"s3_bucket_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: verify.ValidARN,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+						"text_log_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"destination": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cloudwatch": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"cloudwatch_log_group_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: verify.ValidARN,
+															},
+															"log_prefix": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"sentiment_analysis_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"detect_sentiment": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceBotAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	botID := d.Get("bot_id").(string)
+	name := d.Get("name").(string)
+	input := &lexmodelsv2.CreateBotAliasInput{
+		BotAliasName: aws.String(name),
+		BotId:        aws.String(botID),
+	}
+
+	if v, ok := d.GetOk("bot_alias_locale_settings"); ok && v.(*schema.Set).Len() > 0 {
+		input.BotAliasLocaleSettings = expandBotAliasLocaleSettings(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("bot_version"); ok {
+		input.BotVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("conversation_log_settings"); ok {
+		input.ConversationLogSettings = expandConversationLogSettings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sentiment_analysis_settings"); ok {
+		input.SentimentAnalysisSettings = expandSentimentAnalysisSettings(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if err := prepareBotAliasVersion(ctx, conn, botID, aws.StringValue(input.BotVersion), input.BotAliasLocaleSettings, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lex V2 Bot Alias (%s): %s", name, err)
+	}
+
+	output, err := conn.CreateBotAliasWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lex V2 Bot Alias (%s): %s", name, err)
+	}
+
+	botAliasID := aws.StringValue(output.BotAliasId)
+	d.SetId(BotAliasCreateResourceID(botAliasID, botID))
+
+	if _, err := waitBotAliasAvailable(ctx, conn, botAliasID, botID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Lex V2 Bot Alias (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBotAliasRead(ctx, d, meta)...)
+}
+
+func resourceBotAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	botAliasID, botID, err := BotAliasParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lex V2 Bot Alias (%s): %s", d.Id(), err)
+	}
+
+	output, err := FindBotAliasByTwoPartKey(ctx, conn, botAliasID, botID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Bot Alias (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lex V2 Bot Alias (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "lex",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("bot-alias/%s/%s", botID, botAliasID),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("bot_alias_id", output.BotAliasId)
+	if err := d.Set("bot_alias_locale_settings", flattenBotAliasLocaleSettings(output.BotAliasLocaleSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting bot_alias_locale_settings: %s", err)
+	}
+	d.Set("bot_id", output.BotId)
+	d.Set("bot_version", output.BotVersion)
+	if err := d.Set("conversation_log_settings", flattenConversationLogSettings(output.ConversationLogSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting conversation_log_settings: %s", err)
+	}
+	d.Set("description", output.Description)
+	d.Set("name", output.BotAliasName)
+	if err := d.Set("sentiment_analysis_settings", flattenSentimentAnalysisSettings(output.SentimentAnalysisSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting sentiment_analysis_settings: %s", err)
+	}
+	d.Set("status", output.BotAliasStatus)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Lex V2 Bot Alias (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceBotAliasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	botAliasID, botID, err := BotAliasParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lex V2 Bot Alias (%s): %s", d.Id(), err)
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &lexmodelsv2.UpdateBotAliasInput{
+			BotAliasId:                aws.String(botAliasID),
+			BotAliasLocaleSettings:    expandBotAliasLocaleSettings(d.Get("bot_alias_locale_settings").(*schema.Set).List()),
+			BotAliasName:              aws.String(d.Get("name").(string)),
+			BotId:                     aws.String(botID),
+			ConversationLogSettings:   expandConversationLogSettings(d.Get("conversation_log_settings").([]interface{})),
+			Description:               aws.String(d.Get("description").(string)),
+			SentimentAnalysisSettings: expandSentimentAnalysisSettings(d.Get("sentiment_analysis_settings").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("bot_version"); ok {
+			input.BotVersion = aws.String(v.(string))
+		}
+
+		if err := prepareBotAliasVersion(ctx, conn, botID, aws.StringValue(input.BotVersion), input.BotAliasLocaleSettings, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lex V2 Bot Alias (%s): %s", d.Id(), err)
+		}
+
+		_, err := conn.UpdateBotAliasWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lex V2 Bot Alias (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitBotAliasAvailable(ctx, conn, botAliasID, botID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Lex V2 Bot Alias (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lex V2 Bot Alias (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceBotAliasRead(ctx, d, meta)...)
+}
+
+func resourceBotAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	botAliasID, botID, err := BotAliasParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lex V2 Bot Alias (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Lex V2 Bot Alias: %s", d.Id())
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteBotAliasWithContext(ctx, &lexmodelsv2.DeleteBotAliasInput{
+			BotAliasId: aws.String(botAliasID),
+			BotId:      aws.String(botID),
+		})
+	}, lexmodelsv2.ErrCodePreconditionFailedException)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lex V2 Bot Alias (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBotAliasDeleted(ctx, conn, botAliasID, botID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Lex V2 Bot Alias (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// prepareBotAliasVersion makes sure the bot version an alias points at is ready to serve traffic.
+// Locales of the DRAFT version are built on demand, numbered versions must have finished versioning.
+func prepareBotAliasVersion(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion string, localeSettings map[string]*lexmodelsv2.BotAliasLocaleSettings, timeout time.Duration) error {
+	switch botVersion {
+	case "":
+		return nil
+	case draftBotVersion:
+		for localeID, v := range localeSettings {
+			if !aws.BoolValue(v.Enabled) {
+				continue
+			}
+
+			if err := buildBotLocale(ctx, conn, botID, botVersion, localeID, timeout); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		if _, err := waitBotVersionAvailable(ctx, conn, botID, botVersion, timeout); err != nil {
+			return fmt.Errorf("waiting for Lex V2 Bot Version (%s) create: %w", BotVersionCreateResourceID(botID, botVersion), err)
+		}
+
+		return nil
+	}
+}
+
+const botAliasResourceIDSeparator = ","
+
+func BotAliasCreateResourceID(botAliasID, botID string) string {
+	parts := []string{botAliasID, botID}
+	id := strings.Join(parts, botAliasResourceIDSeparator)
+
+	return id
+}
+
+func BotAliasParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, botAliasResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected bot-alias-id%[2]sbot-id", id, botAliasResourceIDSeparator)
+}
+
+func FindBotAliasByTwoPartKey(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botAliasID, botID string) (*lexmodelsv2.DescribeBotAliasOutput, error) {
+	input := &lexmodelsv2.DescribeBotAliasInput{
+		BotAliasId: aws.String(botAliasID),
+		BotId:      aws.String(botID),
+	}
+
+	output, err := conn.DescribeBotAliasWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusBotAlias(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botAliasID, botID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindBotAliasByTwoPartKey(ctx, conn, botAliasID, botID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.BotAliasStatus), nil
+	}
+}
+
+func waitBotAliasAvailable(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botAliasID, botID string, timeout time.Duration) (*lexmodelsv2.DescribeBotAliasOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotAliasStatusCreating},
+		Target:  []string{lexmodelsv2.BotAliasStatusAvailable},
+		Refresh: statusBotAlias(ctx, conn, botAliasID, botID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotAliasOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBotAliasDeleted(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botAliasID, botID string, timeout time.Duration) (*lexmodelsv2.DescribeBotAliasOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotAliasStatusDeleting},
+		Target:  []string{},
+		Refresh: statusBotAlias(ctx, conn, botAliasID, botID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotAliasOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandBotAliasLocaleSettings(tfList []interface{}) map[string]*lexmodelsv2.BotAliasLocaleSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*lexmodelsv2.BotAliasLocaleSettings)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lexmodelsv2.BotAliasLocaleSettings{
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+		}
+
+		if v, ok := tfMap["code_hook_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.CodeHookSpecification = expandCodeHookSpecification(v[0].(map[string]interface{}))
+		}
+
+		apiObjects[tfMap["locale_id"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandCodeHookSpecification(tfMap map[string]interface{}) *lexmodelsv2.CodeHookSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.CodeHookSpecification{}
+
+	if v, ok := tfMap["lambda_code_hook"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.LambdaCodeHook = &lexmodelsv2.LambdaCodeHook{
+			CodeHookInterfaceVersion: aws.String(tfMap["code_hook_interface_version"].(string)),
+			LambdaARN:                aws.String(tfMap["lambda_arn"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenBotAliasLocaleSettings(apiObjects map[string]*lexmodelsv2.BotAliasLocaleSettings) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for localeID, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"enabled":   aws.BoolValue(apiObject.Enabled),
+			"locale_id": localeID,
+		}
+
+		if v := apiObject.CodeHookSpecification; v != nil && v.LambdaCodeHook != nil {
+			tfMap["code_hook_specification"] = []interface{}{map[string]interface{}{
+				"lambda_code_hook": []interface{}{map[string]interface{}{
+					"code_hook_interface_version": aws.StringValue(v.LambdaCodeHook.CodeHookInterfaceVersion),
+					"lambda_arn":                  aws.StringValue(v.LambdaCodeHook.LambdaARN),
+				}},
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandConversationLogSettings(tfList []interface{}) *lexmodelsv2.ConversationLogSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &lexmodelsv2.ConversationLogSettings{}
+
+	if v, ok := tfMap["audio_log_settings"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AudioLogSettings = expandAudioLogSettings(v)
+	}
+
+	if v, ok := tfMap["text_log_settings"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TextLogSettings = expandTextLogSettings(v)
+	}
+
+	return apiObject
+}
+
+func expandAudioLogSettings(tfList []interface{}) []*lexmodelsv2.AudioLogSetting {
+	var apiObjects []*lexmodelsv2.AudioLogSetting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lexmodelsv2.AudioLogSetting{
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+		}
+
+		if v, ok := tfMap["destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["s3_bucket"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				s3Bucket := &lexmodelsv2.S3BucketLogDestination{
+					LogPrefix:   aws.String(tfMap["log_prefix"].(string)),
+					S3BucketArn: aws.String(tfMap["s3_bucket_arn"].(string)),
+				}
+
+				if v, ok := tfMap["kms_key_arn"].(string); ok && v != "" {
+					s3Bucket.KmsKeyArn = aws.String(v)
+				}
+
+				apiObject.Destination = &lexmodelsv2.AudioLogDestination{
+					S3Bucket: s3Bucket,
+				}
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTextLogSettings(tfList []interface{}) []*lexmodelsv2.TextLogSetting {
+	var apiObjects []*lexmodelsv2.TextLogSetting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lexmodelsv2.TextLogSetting{
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+		}
+
+		if v, ok := tfMap["destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["cloudwatch"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+
+				apiObject.Destination = &lexmodelsv2.TextLogDestination{
+					CloudWatch: &lexmodelsv2.CloudWatchLogGroupLogDestination{
+						CloudWatchLogGroupArn: aws.String(tfMap["cloudwatch_log_group_arn"].(string)),
+						LogPrefix:             aws.String(tfMap["log_prefix"].(string)),
+					},
+				}
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenConversationLogSettings(apiObject *lexmodelsv2.ConversationLogSettings) []interface{} {
+	if apiObject == nil || (len(apiObject.AudioLogSettings) == 0 && len(apiObject.TextLogSettings) == 0) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AudioLogSettings; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			if apiObject == nil {
+				continue
+			}
+
+			tfMap := map[string]interface{}{
+				"enabled": aws.BoolValue(apiObject.Enabled),
+			}
+
+			if v := apiObject.Destination; v != nil && v.S3Bucket != nil {
+				tfMap["destination"] = []interface{}{map[string]interface{}{
+					"s3_bucket": []interface{}{map[string]interface{}{
+						"kms_key_arn":   aws.StringValue(v.S3Bucket.KmsKeyArn),
+						"log_prefix":    aws.StringValue(v.S3Bucket.LogPrefix),
+						"s3_bucket_arn": aws.StringValue(v.S3Bucket.S3BucketArn),
+					}},
+				}}
+			}
+
+			tfList = append(tfList, tfMap)
+		}
+
+		tfMap["audio_log_settings"] = tfList
+	}
+
+	if v := apiObject.TextLogSettings; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			if apiObject == nil {
+				continue
+			}
+
+			tfMap := map[string]interface{}{
+				"enabled": aws.BoolValue(apiObject.Enabled),
+			}
+
+			if v := apiObject.Destination; v != nil && v.CloudWatch != nil {
+				tfMap["destination"] = []interface{}{map[string]interface{}{
+					"cloudwatch": []interface{}{map[string]interface{}{
+						"cloudwatch_log_group_arn": aws.StringValue(v.CloudWatch.CloudWatchLogGroupArn),
+						"log_prefix":               aws.StringValue(v.CloudWatch.LogPrefix),
+					}},
+				}}
+			}
+
+			tfList = append(tfList, tfMap)
+		}
+
+		tfMap["text_log_settings"] = tfList
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandSentimentAnalysisSettings(tfList []interface{}) *lexmodelsv2.SentimentAnalysisSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &lexmodelsv2.SentimentAnalysisSettings{
+		DetectSentiment: aws.Bool(tfMap["detect_sentiment"].(bool)),
+	}
+}
+
+func flattenSentimentAnalysisSettings(apiObject *lexmodelsv2.SentimentAnalysisSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"detect_sentiment": aws.BoolValue(apiObject.DetectSentiment),
+	}
+
+	return []interface{}{tfMap}
+}
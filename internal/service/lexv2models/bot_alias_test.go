@@ -0,0 +1,225 @@
+package lexv2models_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLexV2ModelsBotAlias_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lexv2models_bot_alias.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lexmodelsv2.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotAliasConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotAliasExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "lex", regexp.MustCompile(`bot-alias/.+/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "bot_alias_id"),
+					resource.TestCheckResourceAttr(resourceName, "bot_alias_locale_settings.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", "aws_lexv2models_bot.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_version", "aws_lexv2models_bot_version.test", "bot_version"),
+					resource.TestCheckResourceAttr(resourceName, "conversation_log_settings.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", lexmodelsv2.BotAliasStatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsBotAlias_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lexv2models_bot_alias.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lexmodelsv2.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotAliasConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotAliasExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflexv2models.ResourceBotAlias(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsBotAlias_conversationLogSettings(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lexv2models_bot_alias.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lexmodelsv2.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotAliasConfig_conversationLogSettings(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotAliasExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "conversation_log_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "conversation_log_settings.0.text_log_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "conversation_log_settings.0.text_log_settings.0.enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "conversation_log_settings.0.text_log_settings.0.destination.0.cloudwatch.0.cloudwatch_log_group_arn", "aws_cloudwatch_log_group.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "conversation_log_settings.0.text_log_settings.0.destination.0.cloudwatch.0.log_prefix", "lex"),
+					resource.TestCheckResourceAttr(resourceName, "sentiment_analysis_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sentiment_analysis_settings.0.detect_sentiment", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBotAliasDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lexv2models_bot_alias" {
+				continue
+			}
+
+			botAliasID, botID, err := tflexv2models.BotAliasParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tflexv2models.FindBotAliasByTwoPartKey(ctx, conn, botAliasID, botID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lex V2 Bot Alias %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBotAliasExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex V2 Bot Alias ID is set")
+		}
+
+		botAliasID, botID, err := tflexv2models.BotAliasParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn()
+
+		_, err = tflexv2models.FindBotAliasByTwoPartKey(ctx, conn, botAliasID, botID)
+
+		return err
+	}
+}
+
+func testAccBotAliasConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBotVersionConfig_basic(rName), fmt.Sprintf(`
+resource "aws_lexv2models_bot_alias" "test" {
+  bot_id      = aws_lexv2models_bot.test.id
+  bot_version = aws_lexv2models_bot_version.test.bot_version
+  name        = %[1]q
+
+  bot_alias_locale_settings {
+    locale_id = aws_lexv2models_bot_locale.test.locale_id
+    enabled   = true
+  }
+}
+`, rName))
+}
+
+func testAccBotAliasConfig_conversationLogSettings(rName string) string {
+	return acctest.ConfigCompose(testAccBotVersionConfig_basic(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_lexv2models_bot_alias" "test" {
+  bot_id      = aws_lexv2models_bot.test.id
+  bot_version = aws_lexv2models_bot_version.test.bot_version
+  name        = %[1]q
+
+  bot_alias_locale_settings {
+    locale_id = aws_lexv2models_bot_locale.test.locale_id
+    enabled   = true
+  }
+
+  conversation_log_settings {
+    text_log_settings {
+      enabled = true
+
+      destination {
+        cloudwatch {
+          cloudwatch_log_group_arn = aws_cloudwatch_log_group.test.arn
+          log_prefix               = "lex"
+        }
+      }
+    }
+  }
+
+  sentiment_analysis_settings {
+    detect_sentiment = false
+  }
+}
+`, rName))
+}
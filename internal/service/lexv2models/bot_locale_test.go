@@ -0,0 +1,156 @@
+package lexv2models_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLexV2ModelsBotLocale_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lexv2models_bot_locale.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lexmodelsv2.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotLocaleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotLocaleConfig_basic(rName, 0.7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotLocaleExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", "aws_lexv2models_bot.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "locale_id", "en_US"),
+					resource.TestCheckResourceAttr(resourceName, "n_lu_intent_confidence_threshold", "0.7"),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "voice_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "voice_settings.0.voice_id", "Kendra"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBotLocaleConfig_basic(rName, 0.5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotLocaleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "n_lu_intent_confidence_threshold", "0.5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsBotLocale_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lexv2models_bot_locale.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lexmodelsv2.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotLocaleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotLocaleConfig_basic(rName, 0.7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotLocaleExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflexv2models.ResourceBotLocale(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBotLocaleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lexv2models_bot_locale" {
+				continue
+			}
+
+			botID, botVersion, localeID, err := tflexv2models.BotLocaleParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tflexv2models.FindBotLocaleByThreePartKey(ctx, conn, botID, botVersion, localeID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lex V2 Bot Locale %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBotLocaleExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex V2 Bot Locale ID is set")
+		}
+
+		botID, botVersion, localeID, err := tflexv2models.BotLocaleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn()
+
+		_, err = tflexv2models.FindBotLocaleByThreePartKey(ctx, conn, botID, botVersion, localeID)
+
+		return err
+	}
+}
+
+func testAccBotLocaleConfig_basic(rName string, threshold float64) string {
+	return acctest.ConfigCompose(testAccBotConfig_basic(rName), fmt.Sprintf(`
+resource "aws_lexv2models_bot_locale" "test" {
+  bot_id                           = aws_lexv2models_bot.test.id
+  locale_id                        = "en_US"
+  n_lu_intent_confidence_threshold = %[1]g
+
+  voice_settings {
+    voice_id = "Kendra"
+  }
+}
+`, threshold))
+}
@@ -0,0 +1,342 @@
+package lexv2models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBotVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBotVersionCreate,
+		ReadWithoutTimeout:   resourceBotVersionRead,
+		DeleteWithoutTimeout: resourceBotVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"locale_specification": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"locale_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"source_bot_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  draftBotVersion,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBotVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	botID := d.Get("bot_id").(string)
+	localeSpecifications := expandBotVersionLocaleSpecifications(d.Get("locale_specification").(*schema.Set).List())
+
+	// Locales sourced from the working draft must be built before they can be versioned.
+	for localeID, v := range localeSpecifications {
+		if aws.StringValue(v.SourceBotVersion) != draftBotVersion {
+			continue
+		}
+
+		if err := buildBotLocale(ctx, conn, botID, draftBotVersion, localeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	input := &lexmodelsv2.CreateBotVersionInput{
+		BotId:                         aws.String(botID),
+		BotVersionLocaleSpecification: localeSpecifications,
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return conn.CreateBotVersionWithContext(ctx, input)
+	}, lexmodelsv2.ErrCodePreconditionFailedException)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lex V2 Bot Version (%s): %s", botID, err)
+	}
+
+	botVersion := aws.StringValue(outputRaw.(*lexmodelsv2.CreateBotVersionOutput).BotVersion)
+	d.SetId(BotVersionCreateResourceID(botID, botVersion))
+
+	if _, err := waitBotVersionAvailable(ctx, conn, botID, botVersion, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Lex V2 Bot Version (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBotVersionRead(ctx, d, meta)...)
+}
+
+func resourceBotVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	botID, botVersion, err := BotVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lex V2 Bot Version (%s): %s", d.Id(), err)
+	}
+
+	output, err := FindBotVersionByTwoPartKey(ctx, conn, botID, botVersion)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Bot Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lex V2 Bot Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bot_id", output.BotId)
+	d.Set("bot_version", output.BotVersion)
+	d.Set("description", output.Description)
+	d.Set("status", output.BotStatus)
+
+	return diags
+}
+
+func resourceBotVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	botID, botVersion, err := BotVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lex V2 Bot Version (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Lex V2 Bot Version: %s", d.Id())
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteBotVersionWithContext(ctx, &lexmodelsv2.DeleteBotVersionInput{
+			BotId:      aws.String(botID),
+			BotVersion: aws.String(botVersion),
+		})
+	}, lexmodelsv2.ErrCodePreconditionFailedException)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lex V2 Bot Version (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBotVersionDeleted(ctx, conn, botID, botVersion, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Lex V2 Bot Version (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// buildBotLocale builds the specified bot locale unless it has already been built
+// since its last modification, and waits for the build to complete.
+func buildBotLocale(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string, timeout time.Duration) error {
+	id := BotLocaleCreateResourceID(botID, botVersion, localeID)
+	output, err := FindBotLocaleByThreePartKey(ctx, conn, botID, botVersion, localeID)
+
+	if err != nil {
+		return fmt.Errorf("reading Lex V2 Bot Locale (%s): %w", id, err)
+	}
+
+	if aws.StringValue(output.BotLocaleStatus) == lexmodelsv2.BotLocaleStatusBuilt {
+		return nil
+	}
+
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+		return conn.BuildBotLocaleWithContext(ctx, &lexmodelsv2.BuildBotLocaleInput{
+			BotId:      aws.String(botID),
+			BotVersion: aws.String(botVersion),
+			LocaleId:   aws.String(localeID),
+		})
+	}, lexmodelsv2.ErrCodePreconditionFailedException)
+
+	if err != nil {
+		return fmt.Errorf("building Lex V2 Bot Locale (%s): %w", id, err)
+	}
+
+	if _, err := waitBotLocaleBuilt(ctx, conn, botID, botVersion, localeID, timeout); err != nil {
+		return fmt.Errorf("waiting for Lex V2 Bot Locale (%s) build: %w", id, err)
+	}
+
+	return nil
+}
+
+const botVersionResourceIDSeparator = ","
+
+func BotVersionCreateResourceID(botID, botVersion string) string {
+	parts := []string{botID, botVersion}
+	id := strings.Join(parts, botVersionResourceIDSeparator)
+
+	return id
+}
+
+func BotVersionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, botVersionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected bot-id%[2]sbot-version", id, botVersionResourceIDSeparator)
+}
+
+func FindBotVersionByTwoPartKey(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion string) (*lexmodelsv2.DescribeBotVersionOutput, error) {
+	input := &lexmodelsv2.DescribeBotVersionInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+	}
+
+	output, err := conn.DescribeBotVersionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusBotVersion(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindBotVersionByTwoPartKey(ctx, conn, botID, botVersion)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.BotStatus), nil
+	}
+}
+
+func waitBotVersionAvailable(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion string, timeout time.Duration) (*lexmodelsv2.DescribeBotVersionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotStatusCreating, lexmodelsv2.BotStatusVersioning},
+		Target:  []string{lexmodelsv2.BotStatusAvailable},
+		Refresh: statusBotVersion(ctx, conn, botID, botVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotVersionOutput); ok {
+		if status := aws.StringValue(output.BotStatus); status == lexmodelsv2.BotStatusFailed {
+			tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBotVersionDeleted(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion string, timeout time.Duration) (*lexmodelsv2.DescribeBotVersionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotStatusDeleting},
+		Target:  []string{},
+		Refresh: statusBotVersion(ctx, conn, botID, botVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotVersionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandBotVersionLocaleSpecifications(tfList []interface{}) map[string]*lexmodelsv2.BotVersionLocaleDetails {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*lexmodelsv2.BotVersionLocaleDetails)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects[tfMap["locale_id"].(string)] = &lexmodelsv2.BotVersionLocaleDetails{
+			SourceBotVersion: aws.String(tfMap["source_bot_version"].(string)),
+		}
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,148 @@
+package lexv2models_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLexV2ModelsBotVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lexv2models_bot_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lexmodelsv2.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", "aws_lexv2models_bot.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "locale_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", lexmodelsv2.BotStatusAvailable),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"locale_specification"},
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsBotVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lexv2models_bot_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lexmodelsv2.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotVersionExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflexv2models.ResourceBotVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBotVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lexv2models_bot_version" {
+				continue
+			}
+
+			botID, botVersion, err := tflexv2models.BotVersionParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tflexv2models.FindBotVersionByTwoPartKey(ctx, conn, botID, botVersion)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lex V2 Bot Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBotVersionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex V2 Bot Version ID is set")
+		}
+
+		botID, botVersion, err := tflexv2models.BotVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn()
+
+		_, err = tflexv2models.FindBotVersionByTwoPartKey(ctx, conn, botID, botVersion)
+
+		return err
+	}
+}
+
+func testAccBotVersionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccIntentConfig_basic(rName, "I want to order flowers"), `
+resource "aws_lexv2models_bot_version" "test" {
+  bot_id = aws_lexv2models_bot.test.id
+
+  locale_specification {
+    locale_id          = aws_lexv2models_bot_locale.test.locale_id
+    source_bot_version = "DRAFT"
+  }
+
+  depends_on = [aws_lexv2models_intent.test]
+}
+`)
+}
@@ -0,0 +1,5 @@
+package lexv2models
+
+const (
+	draftBotVersion = "DRAFT"
+)
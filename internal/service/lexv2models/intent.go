@@ -0,0 +1,414 @@
+package lexv2models
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceIntent() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIntentCreate,
+		ReadWithoutTimeout:   resourceIntentRead,
+		UpdateWithoutTimeout: resourceIntentUpdate,
+		DeleteWithoutTimeout: resourceIntentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  draftBotVersion,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"dialog_code_hook": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"fulfillment_code_hook": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"intent_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"locale_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"parent_intent_signature": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sample_utterance": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"utterance": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIntentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	botID := d.Get("bot_id").(string)
+	botVersion := d.Get("bot_version").(string)
+	localeID := d.Get("locale_id").(string)
+	name := d.Get("name").(string)
+	input := &lexmodelsv2.CreateIntentInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		IntentName: aws.String(name),
+		LocaleId:   aws.String(localeID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("dialog_code_hook"); ok {
+		input.DialogCodeHook = expandDialogCodeHookSettings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("fulfillment_code_hook"); ok {
+		input.FulfillmentCodeHook = expandFulfillmentCodeHookSettings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("parent_intent_signature"); ok {
+		input.ParentIntentSignature = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sample_utterance"); ok {
+		input.SampleUtterances = expandSampleUtterances(v.([]interface{}))
+	}
+
+	output, err := conn.CreateIntentWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lex V2 Intent (%s): %s", name, err)
+	}
+
+	d.SetId(IntentCreateResourceID(aws.StringValue(output.IntentId), botID, botVersion, localeID))
+
+	return append(diags, resourceIntentRead(ctx, d, meta)...)
+}
+
+func resourceIntentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	intentID, botID, botVersion, localeID, err := IntentParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lex V2 Intent (%s): %s", d.Id(), err)
+	}
+
+	output, err := FindIntentByFourPartKey(ctx, conn, intentID, botID, botVersion, localeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Intent (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lex V2 Intent (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bot_id", output.BotId)
+	d.Set("bot_version", output.BotVersion)
+	d.Set("description", output.Description)
+	if err := d.Set("dialog_code_hook", flattenDialogCodeHookSettings(output.DialogCodeHook)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting dialog_code_hook: %s", err)
+	}
+	if err := d.Set("fulfillment_code_hook", flattenFulfillmentCodeHookSettings(output.FulfillmentCodeHook)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting fulfillment_code_hook: %s", err)
+	}
+	d.Set("intent_id", output.IntentId)
+	d.Set("locale_id", output.LocaleId)
+	d.Set("name", output.IntentName)
+	d.Set("parent_intent_signature", output.ParentIntentSignature)
+	if err := d.Set("sample_utterance", flattenSampleUtterances(output.SampleUtterances)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting sample_utterance: %s", err)
+	}
+
+	return diags
+}
+
+func resourceIntentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	intentID, botID, botVersion, localeID, err := IntentParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lex V2 Intent (%s): %s", d.Id(), err)
+	}
+
+	// UpdateIntent replaces the whole intent definition, so carry over
+	// the settings this resource does not manage (e.g. slot priorities).
+	output, err := FindIntentByFourPartKey(ctx, conn, intentID, botID, botVersion, localeID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lex V2 Intent (%s): %s", d.Id(), err)
+	}
+
+	input := &lexmodelsv2.UpdateIntentInput{
+		BotId:                     aws.String(botID),
+		BotVersion:                aws.String(botVersion),
+		Description:               aws.String(d.Get("description").(string)),
+		DialogCodeHook:            expandDialogCodeHookSettings(d.Get("dialog_code_hook").([]interface{})),
+		FulfillmentCodeHook:       expandFulfillmentCodeHookSettings(d.Get("fulfillment_code_hook").([]interface{})),
+		InitialResponseSetting:    output.InitialResponseSetting,
+		InputContexts:             output.InputContexts,
+		IntentClosingSetting:      output.IntentClosingSetting,
+		IntentConfirmationSetting: output.IntentConfirmationSetting,
+		IntentId:                  aws.String(intentID),
+		IntentName:                aws.String(d.Get("name").(string)),
+		KendraConfiguration:       output.KendraConfiguration,
+		LocaleId:                  aws.String(localeID),
+		OutputContexts:            output.OutputContexts,
+		SampleUtterances:          expandSampleUtterances(d.Get("sample_utterance").([]interface{})),
+		SlotPriorities:            output.SlotPriorities,
+	}
+
+	if v, ok := d.GetOk("parent_intent_signature"); ok {
+		input.ParentIntentSignature = aws.String(v.(string))
+	}
+
+	_, err = conn.UpdateIntentWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lex V2 Intent (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceIntentRead(ctx, d, meta)...)
+}
+
+func resourceIntentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	intentID, botID, botVersion, localeID, err := IntentParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lex V2 Intent (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Lex V2 Intent: %s", d.Id())
+	_, err = conn.DeleteIntentWithContext(ctx, &lexmodelsv2.DeleteIntentInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		IntentId:   aws.String(intentID),
+		LocaleId:   aws.String(localeID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lex V2 Intent (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const intentResourceIDSeparator = ","
+
+func IntentCreateResourceID(intentID, botID, botVersion, localeID string) string {
+	parts := []string{intentID, botID, botVersion, localeID}
+	id := strings.Join(parts, intentResourceIDSeparator)
+
+	return id
+}
+
+func IntentParseResourceID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, intentResourceIDSeparator)
+
+	if len(parts) == 4 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" {
+		return parts[0], parts[1], parts[2], parts[3], nil
+	}
+
+	return "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected intent-id%[2]sbot-id%[2]sbot-version%[2]slocale-id", id, intentResourceIDSeparator)
+}
+
+func FindIntentByFourPartKey(ctx context.Context, conn *lexmodelsv2.LexModelsV2, intentID, botID, botVersion, localeID string) (*lexmodelsv2.DescribeIntentOutput, error) {
+	input := &lexmodelsv2.DescribeIntentInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		IntentId:   aws.String(intentID),
+		LocaleId:   aws.String(localeID),
+	}
+
+	output, err := conn.DescribeIntentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandDialogCodeHookSettings(tfList []interface{}) *lexmodelsv2.DialogCodeHookSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &lexmodelsv2.DialogCodeHookSettings{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+	}
+}
+
+func flattenDialogCodeHookSettings(apiObject *lexmodelsv2.DialogCodeHookSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled": aws.BoolValue(apiObject.Enabled),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandFulfillmentCodeHookSettings(tfList []interface{}) *lexmodelsv2.FulfillmentCodeHookSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &lexmodelsv2.FulfillmentCodeHookSettings{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["active"].(bool); ok {
+		apiObject.Active = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenFulfillmentCodeHookSettings(apiObject *lexmodelsv2.FulfillmentCodeHookSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"active":  aws.BoolValue(apiObject.Active),
+		"enabled": aws.BoolValue(apiObject.Enabled),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandSampleUtterances(tfList []interface{}) []*lexmodelsv2.SampleUtterance {
+	var apiObjects []*lexmodelsv2.SampleUtterance
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &lexmodelsv2.SampleUtterance{
+			Utterance: aws.String(tfMap["utterance"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenSampleUtterances(apiObjects []*lexmodelsv2.SampleUtterance) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"utterance": aws.StringValue(apiObject.Utterance),
+		})
+	}
+
+	return tfList
+}
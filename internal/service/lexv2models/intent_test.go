@@ -0,0 +1,157 @@
+package lexv2models_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLexV2ModelsIntent_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lexv2models_intent.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lexmodelsv2.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntentConfig_basic(rName, "I want to order flowers"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntentExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", "aws_lexv2models_bot.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "DRAFT"),
+					resource.TestCheckResourceAttrSet(resourceName, "intent_id"),
+					resource.TestCheckResourceAttr(resourceName, "locale_id", "en_US"),
+					resource.TestCheckResourceAttr(resourceName, "name", "OrderFlowers"),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterance.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterance.0.utterance", "I want to order flowers"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIntentConfig_basic(rName, "I would like to pick up flowers"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterance.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterance.0.utterance", "I would like to pick up flowers"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsIntent_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lexv2models_intent.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lexmodelsv2.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntentConfig_basic(rName, "I want to order flowers"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntentExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflexv2models.ResourceIntent(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIntentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lexv2models_intent" {
+				continue
+			}
+
+			intentID, botID, botVersion, localeID, err := tflexv2models.IntentParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tflexv2models.FindIntentByFourPartKey(ctx, conn, intentID, botID, botVersion, localeID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lex V2 Intent %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIntentExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex V2 Intent ID is set")
+		}
+
+		intentID, botID, botVersion, localeID, err := tflexv2models.IntentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn()
+
+		_, err = tflexv2models.FindIntentByFourPartKey(ctx, conn, intentID, botID, botVersion, localeID)
+
+		return err
+	}
+}
+
+func testAccIntentConfig_basic(rName, utterance string) string {
+	return acctest.ConfigCompose(testAccBotLocaleConfig_basic(rName, 0.7), fmt.Sprintf(`
+resource "aws_lexv2models_intent" "test" {
+  bot_id    = aws_lexv2models_bot.test.id
+  locale_id = aws_lexv2models_bot_locale.test.locale_id
+  name      = "OrderFlowers"
+
+  sample_utterance {
+    utterance = %[1]q
+  }
+}
+`, utterance))
+}
@@ -0,0 +1,531 @@
+package lexv2models
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceSlot() *schema.Resource {
+	messageSchema := func() *schema.Resource {
+		return &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"plain_text_message": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"value": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 1000),
+							},
+						},
+					},
+				},
+				"ssml_message": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"value": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 1000),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSlotCreate,
+		ReadWithoutTimeout:   resourceSlotRead,
+		UpdateWithoutTimeout: resourceSlotUpdate,
+		DeleteWithoutTimeout: resourceSlotDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  draftBotVersion,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"intent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"locale_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"slot_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"slot_type_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"value_elicitation_setting": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prompt_specification": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allow_interrupt": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+									"max_retries": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, 5),
+									},
+									"message_group": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 5,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"message": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem:     messageSchema(),
+												},
+												"variation": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 2,
+													Elem:     messageSchema(),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"slot_constraint": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lexmodelsv2.SlotConstraint_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSlotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	botID := d.Get("bot_id").(string)
+	botVersion := d.Get("bot_version").(string)
+	intentID := d.Get("intent_id").(string)
+	localeID := d.Get("locale_id").(string)
+	name := d.Get("name").(string)
+	input := &lexmodelsv2.CreateSlotInput{
+		BotId:                   aws.String(botID),
+		BotVersion:              aws.String(botVersion),
+		IntentId:                aws.String(intentID),
+		LocaleId:                aws.String(localeID),
+		SlotName:                aws.String(name),
+		ValueElicitationSetting: expandSlotValueElicitationSetting(d.Get("value_elicitation_setting").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("slot_type_id"); ok {
+		input.SlotTypeId = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateSlotWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lex V2 Slot (%s): %s", name, err)
+	}
+
+	d.SetId(SlotCreateResourceID(aws.StringValue(output.SlotId), botID, botVersion, localeID, intentID))
+
+	return append(diags, resourceSlotRead(ctx, d, meta)...)
+}
+
+func resourceSlotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	slotID, botID, botVersion, localeID, intentID, err := SlotParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lex V2 Slot (%s): %s", d.Id(), err)
+	}
+
+	output, err := FindSlotByFivePartKey(ctx, conn, slotID, botID, botVersion, localeID, intentID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Slot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lex V2 Slot (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bot_id", output.BotId)
+	d.Set("bot_version", output.BotVersion)
+	d.Set("description", output.Description)
+	d.Set("intent_id", output.IntentId)
+	d.Set("locale_id", output.LocaleId)
+	d.Set("name", output.SlotName)
+	d.Set("slot_id", output.SlotId)
+	d.Set("slot_type_id", output.SlotTypeId)
+	if err := d.Set("value_elicitation_setting", flattenSlotValueElicitationSetting(output.ValueElicitationSetting)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting value_elicitation_setting: %s", err)
+	}
+
+	return diags
+}
+
+func resourceSlotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	slotID, botID, botVersion, localeID, intentID, err := SlotParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lex V2 Slot (%s): %s", d.Id(), err)
+	}
+
+	// UpdateSlot replaces the whole slot definition, so carry over
+	// the settings this resource does not manage.
+	output, err := FindSlotByFivePartKey(ctx, conn, slotID, botID, botVersion, localeID, intentID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lex V2 Slot (%s): %s", d.Id(), err)
+	}
+
+	valueElicitationSetting := expandSlotValueElicitationSetting(d.Get("value_elicitation_setting").([]interface{}))
+
+	if v := output.ValueElicitationSetting; v != nil {
+		valueElicitationSetting.DefaultValueSpecification = v.DefaultValueSpecification
+		valueElicitationSetting.SampleUtterances = v.SampleUtterances
+		valueElicitationSetting.SlotCaptureSetting = v.SlotCaptureSetting
+		valueElicitationSetting.WaitAndContinueSpecification = v.WaitAndContinueSpecification
+
+		if o, n := v.PromptSpecification, valueElicitationSetting.PromptSpecification; o != nil && n != nil {
+			n.MessageSelectionStrategy = o.MessageSelectionStrategy
+			n.PromptAttemptsSpecification = o.PromptAttemptsSpecification
+		}
+	}
+
+	input := &lexmodelsv2.UpdateSlotInput{
+		BotId:                   aws.String(botID),
+		BotVersion:              aws.String(botVersion),
+		Description:             aws.String(d.Get("description").(string)),
+		IntentId:                aws.String(intentID),
+		LocaleId:                aws.String(localeID),
+		MultipleValuesSetting:   output.MultipleValuesSetting,
+		ObfuscationSetting:      output.ObfuscationSetting,
+		SlotId:                  aws.String(slotID),
+		SlotName:                aws.String(d.Get("name").(string)),
+		SubSlotSetting:          output.SubSlotSetting,
+		ValueElicitationSetting: valueElicitationSetting,
+	}
+
+	if v, ok := d.GetOk("slot_type_id"); ok {
+		input.SlotTypeId = aws.String(v.(string))
+	}
+
+	_, err = conn.UpdateSlotWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lex V2 Slot (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSlotRead(ctx, d, meta)...)
+}
+
+func resourceSlotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	slotID, botID, botVersion, localeID, intentID, err := SlotParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lex V2 Slot (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Lex V2 Slot: %s", d.Id())
+	_, err = conn.DeleteSlotWithContext(ctx, &lexmodelsv2.DeleteSlotInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		IntentId:   aws.String(intentID),
+		LocaleId:   aws.String(localeID),
+		SlotId:     aws.String(slotID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lex V2 Slot (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const slotResourceIDSeparator = ","
+
+func SlotCreateResourceID(slotID, botID, botVersion, localeID, intentID string) string {
+	parts := []string{slotID, botID, botVersion, localeID, intentID}
+	id := strings.Join(parts, slotResourceIDSeparator)
+
+	return id
+}
+
+func SlotParseResourceID(id string) (string, string, string, string, string, error) {
+	parts := strings.Split(id, slotResourceIDSeparator)
+
+	if len(parts) == 5 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" && parts[4] != "" {
+		return parts[0], parts[1], parts[2], parts[3], parts[4], nil
+	}
+
+	return "", "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected slot-id%[2]sbot-id%[2]sbot-version%[2]slocale-id%[2]sintent-id", id, slotResourceIDSeparator)
+}
+
+func FindSlotByFivePartKey(ctx context.Context, conn *lexmodelsv2.LexModelsV2, slotID, botID, botVersion, localeID, intentID string) (*lexmodelsv2.DescribeSlotOutput, error) {
+	input := &lexmodelsv2.DescribeSlotInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		IntentId:   aws.String(intentID),
+		LocaleId:   aws.String(localeID),
+		SlotId:     aws.String(slotID),
+	}
+
+	output, err := conn.DescribeSlotWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandSlotValueElicitationSetting(tfList []interface{}) *lexmodelsv2.SlotValueElicitationSetting {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &lexmodelsv2.SlotValueElicitationSetting{
+		SlotConstraint: aws.String(tfMap["slot_constraint"].(string)),
+	}
+
+	if v, ok := tfMap["prompt_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PromptSpecification = expandPromptSpecification(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandPromptSpecification(tfMap map[string]interface{}) *lexmodelsv2.PromptSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.PromptSpecification{
+		MaxRetries: aws.Int64(int64(tfMap["max_retries"].(int))),
+	}
+
+	if v, ok := tfMap["allow_interrupt"].(bool); ok {
+		apiObject.AllowInterrupt = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["message_group"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			messageGroup := &lexmodelsv2.MessageGroup{}
+
+			if v, ok := tfMap["message"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				messageGroup.Message = expandMessage(v[0].(map[string]interface{}))
+			}
+
+			if v, ok := tfMap["variation"].([]interface{}); ok && len(v) > 0 {
+				for _, v := range v {
+					if v, ok := v.(map[string]interface{}); ok {
+						messageGroup.Variations = append(messageGroup.Variations, expandMessage(v))
+					}
+				}
+			}
+
+			apiObject.MessageGroups = append(apiObject.MessageGroups, messageGroup)
+		}
+	}
+
+	return apiObject
+}
+
+func expandMessage(tfMap map[string]interface{}) *lexmodelsv2.Message {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.Message{}
+
+	if v, ok := tfMap["plain_text_message"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PlainTextMessage = &lexmodelsv2.PlainTextMessage{
+			Value: aws.String(v[0].(map[string]interface{})["value"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["ssml_message"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SsmlMessage = &lexmodelsv2.SSMLMessage{
+			Value: aws.String(v[0].(map[string]interface{})["value"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenSlotValueElicitationSetting(apiObject *lexmodelsv2.SlotValueElicitationSetting) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"slot_constraint": aws.StringValue(apiObject.SlotConstraint),
+	}
+
+	if v := apiObject.PromptSpecification; v != nil {
+		tfMap["prompt_specification"] = flattenPromptSpecification(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenPromptSpecification(apiObject *lexmodelsv2.PromptSpecification) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allow_interrupt": aws.BoolValue(apiObject.AllowInterrupt),
+		"max_retries":     aws.Int64Value(apiObject.MaxRetries),
+	}
+
+	var messageGroups []interface{}
+
+	for _, v := range apiObject.MessageGroups {
+		if v == nil {
+			continue
+		}
+
+		messageGroup := map[string]interface{}{
+			"message": flattenMessage(v.Message),
+		}
+
+		var variations []interface{}
+
+		for _, v := range v.Variations {
+			variations = append(variations, flattenMessage(v)...)
+		}
+
+		messageGroup["variation"] = variations
+
+		messageGroups = append(messageGroups, messageGroup)
+	}
+
+	tfMap["message_group"] = messageGroups
+
+	return []interface{}{tfMap}
+}
+
+func flattenMessage(apiObject *lexmodelsv2.Message) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.PlainTextMessage; v != nil {
+		tfMap["plain_text_message"] = []interface{}{map[string]interface{}{
+			"value": aws.StringValue(v.Value),
+		}}
+	}
+
+	if v := apiObject.SsmlMessage; v != nil {
+		tfMap["ssml_message"] = []interface{}{map[string]interface{}{
+			"value": aws.StringValue(v.Value),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,185 @@
+package lexv2models_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLexV2ModelsSlot_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lexv2models_slot.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lexmodelsv2.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlotDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlotConfig_basic(rName, "What type of flowers would you like to order?"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlotExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "intent_id", "aws_lexv2models_intent.test", "intent_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "FlowerType"),
+					resource.TestCheckResourceAttrSet(resourceName, "slot_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "slot_type_id", "aws_lexv2models_slot_type.test", "slot_type_id"),
+					resource.TestCheckResourceAttr(resourceName, "value_elicitation_setting.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "value_elicitation_setting.0.slot_constraint", "Required"),
+					resource.TestCheckResourceAttr(resourceName, "value_elicitation_setting.0.prompt_specification.0.max_retries", "2"),
+					resource.TestCheckResourceAttr(resourceName, "value_elicitation_setting.0.prompt_specification.0.message_group.0.message.0.plain_text_message.0.value", "What type of flowers would you like to order?"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSlotConfig_basic(rName, "Which flowers would you like?"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlotExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value_elicitation_setting.0.prompt_specification.0.message_group.0.message.0.plain_text_message.0.value", "Which flowers would you like?"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsSlot_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lexv2models_slot.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lexmodelsv2.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlotDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlotConfig_basic(rName, "What type of flowers would you like to order?"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlotExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflexv2models.ResourceSlot(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSlotDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lexv2models_slot" {
+				continue
+			}
+
+			slotID, botID, botVersion, localeID, intentID, err := tflexv2models.SlotParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tflexv2models.FindSlotByFivePartKey(ctx, conn, slotID, botID, botVersion, localeID, intentID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lex V2 Slot %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSlotExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex V2 Slot ID is set")
+		}
+
+		slotID, botID, botVersion, localeID, intentID, err := tflexv2models.SlotParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn()
+
+		_, err = tflexv2models.FindSlotByFivePartKey(ctx, conn, slotID, botID, botVersion, localeID, intentID)
+
+		return err
+	}
+}
+
+func testAccSlotConfig_basic(rName, prompt string) string {
+	return acctest.ConfigCompose(testAccIntentConfig_basic(rName, "I want to order flowers"), fmt.Sprintf(`
+resource "aws_lexv2models_slot_type" "test" {
+  bot_id    = aws_lexv2models_bot.test.id
+  locale_id = aws_lexv2models_bot_locale.test.locale_id
+  name      = "FlowerTypes"
+
+  slot_type_values {
+    sample_value = "tulips"
+  }
+
+  value_selection_setting {
+    resolution_strategy = "OriginalValue"
+  }
+}
+
+resource "aws_lexv2models_slot" "test" {
+  bot_id       = aws_lexv2models_bot.test.id
+  locale_id    = aws_lexv2models_bot_locale.test.locale_id
+  intent_id    = aws_lexv2models_intent.test.intent_id
+  name         = "FlowerType"
+  slot_type_id = aws_lexv2models_slot_type.test.slot_type_id
+
+  value_elicitation_setting {
+    slot_constraint = "Required"
+
+    prompt_specification {
+      max_retries = 2
+
+      message_group {
+        message {
+          plain_text_message {
+            value = %[1]q
+          }
+        }
+      }
+    }
+  }
+}
+`, prompt))
+}
@@ -0,0 +1,66 @@
+//go:build sweep
+// +build sweep
+
+package lexv2models
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_lexv2models_bot", &resource.Sweeper{
+		Name: "aws_lexv2models_bot",
+		F:    sweepBots,
+	})
+}
+
+func sweepBots(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).LexModelsV2Conn()
+	input := &lexmodelsv2.ListBotsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListBotsPagesWithContext(ctx, input, func(page *lexmodelsv2.ListBotsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.BotSummaries {
+			r := ResourceBot()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.BotId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Lex V2 Bot sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Lex V2 Bots (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Lex V2 Bots (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package lightsail
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceBucketAccessKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBucketAccessKeyCreate,
+		ReadWithoutTimeout:   resourceBucketAccessKeyRead,
+		DeleteWithoutTimeout: resourceBucketAccessKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bucket_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// The secret access key is only returned when the key is created
+			// and cannot be read back, so it is never refreshed from the API.
+			"secret_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBucketAccessKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LightsailConn()
+
+	bucketName := d.Get("bucket_name").(string)
+	in := lightsail.CreateBucketAccessKeyInput{
+		BucketName: aws.String(bucketName),
+	}
+
+	out, err := conn.CreateBucketAccessKeyWithContext(ctx, &in)
+
+	if err != nil {
+		return create.DiagError(names.Lightsail, lightsail.OperationTypeCreateBucketAccessKey, ResBucketAccessKey, bucketName, err)
+	}
+
+	if len(out.Operations) == 0 {
+		return create.DiagError(names.Lightsail, lightsail.OperationTypeCreateBucketAccessKey, ResBucketAccessKey, bucketName, errors.New("No operations found for Create Bucket Access Key request"))
+	}
+
+	op := out.Operations[0]
+
+	err = waitOperation(ctx, conn, op.Id)
+	if err != nil {
+		return create.DiagError(names.Lightsail, lightsail.OperationTypeCreateBucketAccessKey, ResBucketAccessKey, bucketName, errors.New("Error waiting for Create Bucket Access Key request operation"))
+	}
+
+	// Generate an ID
+	vars := []string{
+		bucketName,
+		aws.StringValue(out.AccessKey.AccessKeyId),
+	}
+
+	d.SetId(strings.Join(vars, ","))
+	d.Set("secret_access_key", out.AccessKey.SecretAccessKey)
+
+	return resourceBucketAccessKeyRead(ctx, d, meta)
+}
+
+func resourceBucketAccessKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LightsailConn()
+
+	out, err := FindBucketAccessKeyById(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.Lightsail, create.ErrActionReading, ResBucketAccessKey, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Lightsail, create.ErrActionReading, ResBucketAccessKey, d.Id(), err)
+	}
+
+	d.Set("access_key_id", out.AccessKeyId)
+	d.Set("bucket_name", expandBucketNameFromId(d.Id()))
+	d.Set("created_at", out.CreatedAt.Format(time.RFC3339))
+	d.Set("status", out.Status)
+
+	return nil
+}
+
+func resourceBucketAccessKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LightsailConn()
+
+	id_parts := strings.SplitN(d.Id(), ",", -1)
+	if len(id_parts) != 2 {
+		return nil
+	}
+
+	bucketName := id_parts[0]
+	accessKeyId := id_parts[1]
+
+	out, err := conn.DeleteBucketAccessKeyWithContext(ctx, &lightsail.DeleteBucketAccessKeyInput{
+		AccessKeyId: aws.String(accessKeyId),
+		BucketName:  aws.String(bucketName),
+	})
+
+	if err != nil && tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Lightsail, create.ErrActionDeleting, ResBucketAccessKey, d.Id(), err)
+	}
+
+	if len(out.Operations) == 0 {
+		return create.DiagError(names.Lightsail, lightsail.OperationTypeDeleteBucketAccessKey, ResBucketAccessKey, d.Id(), errors.New("No operations found for Delete Bucket Access Key request"))
+	}
+
+	op := out.Operations[0]
+
+	err = waitOperation(ctx, conn, op.Id)
+
+	if err != nil {
+		return create.DiagError(names.Lightsail, lightsail.OperationTypeDeleteBucketAccessKey, ResBucketAccessKey, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBucketNameFromId(id string) string {
+	id_parts := strings.SplitN(id, ",", -1)
+	bucketName := id_parts[0]
+
+	return bucketName
+}
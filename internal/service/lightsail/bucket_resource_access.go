@@ -0,0 +1,137 @@
+package lightsail
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceBucketResourceAccess() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBucketResourceAccessCreate,
+		ReadWithoutTimeout:   resourceBucketResourceAccessRead,
+		DeleteWithoutTimeout: resourceBucketResourceAccessDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBucketResourceAccessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LightsailConn()
+
+	bucketName := d.Get("bucket_name").(string)
+	resourceName := d.Get("resource_name").(string)
+
+	err := setBucketResourceAccess(ctx, conn, bucketName, resourceName, lightsail.ResourceBucketAccessAllow)
+
+	if err != nil {
+		return create.DiagError(names.Lightsail, lightsail.OperationTypeSetResourceAccessForBucket, ResBucketResourceAccess, bucketName, err)
+	}
+
+	// Generate an ID
+	vars := []string{
+		bucketName,
+		resourceName,
+	}
+
+	d.SetId(strings.Join(vars, ","))
+
+	return resourceBucketResourceAccessRead(ctx, d, meta)
+}
+
+func resourceBucketResourceAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LightsailConn()
+
+	out, err := FindBucketResourceAccessById(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.Lightsail, create.ErrActionReading, ResBucketResourceAccess, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Lightsail, create.ErrActionReading, ResBucketResourceAccess, d.Id(), err)
+	}
+
+	d.Set("bucket_name", expandBucketNameFromId(d.Id()))
+	d.Set("resource_name", out.Name)
+
+	return nil
+}
+
+func resourceBucketResourceAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LightsailConn()
+
+	id_parts := strings.SplitN(d.Id(), ",", -1)
+	if len(id_parts) != 2 {
+		return nil
+	}
+
+	bucketName := id_parts[0]
+	resourceName := id_parts[1]
+
+	err := setBucketResourceAccess(ctx, conn, bucketName, resourceName, lightsail.ResourceBucketAccessDeny)
+
+	if err != nil && tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Lightsail, create.ErrActionDeleting, ResBucketResourceAccess, d.Id(), err)
+	}
+
+	return nil
+}
+
+func setBucketResourceAccess(ctx context.Context, conn *lightsail.Lightsail, bucketName, resourceName, access string) error {
+	in := lightsail.SetResourceAccessForBucketInput{
+		Access:       aws.String(access),
+		BucketName:   aws.String(bucketName),
+		ResourceName: aws.String(resourceName),
+	}
+
+	out, err := conn.SetResourceAccessForBucketWithContext(ctx, &in)
+
+	if err != nil {
+		return err
+	}
+
+	if len(out.Operations) == 0 {
+		return errors.New("No operations found for Set Resource Access For Bucket request")
+	}
+
+	op := out.Operations[0]
+
+	err = waitOperation(ctx, conn, op.Id)
+	if err != nil {
+		return errors.New("Error waiting for Set Resource Access For Bucket request operation")
+	}
+
+	return nil
+}
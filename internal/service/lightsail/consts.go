@@ -2,6 +2,8 @@ package lightsail
 
 const (
 	ResBucket                             = "Bucket"
+	ResBucketAccessKey                    = "Bucket Access Key"
+	ResBucketResourceAccess               = "Bucket Resource Access"
 	ResCertificate                        = "Certificate"
 	ResDatabase                           = "Database"
 	ResDisk                               = "Disk"
@@ -0,0 +1,166 @@
+package lightsail
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceContainerServiceImage() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContainerServiceImageCreate,
+		ReadWithoutTimeout:   resourceContainerServiceImageRead,
+		DeleteWithoutTimeout: resourceContainerServiceImageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"digest": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^sha256:[0-9a-f]{64}$`), "must be a sha256 image digest"),
+			},
+			"image": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 53),
+					validation.StringMatch(regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`), "must contain only lowercase alphanumeric characters and hyphens, and must not start or end with a hyphen"),
+				),
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceContainerServiceImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LightsailConn()
+	serviceName := d.Get("service_name").(string)
+
+	input := &lightsail.RegisterContainerImageInput{
+		Digest:      aws.String(d.Get("digest").(string)),
+		Label:       aws.String(d.Get("label").(string)),
+		ServiceName: aws.String(serviceName),
+	}
+
+	output, err := conn.RegisterContainerImageWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error registering Lightsail Container Service (%s) Image: %s", serviceName, err)
+	}
+
+	if output == nil || output.ContainerImage == nil {
+		return diag.Errorf("error registering Lightsail Container Service (%s) Image: empty output", serviceName)
+	}
+
+	d.SetId(ContainerServiceImageCreateResourceID(serviceName, aws.StringValue(output.ContainerImage.Image)))
+
+	return resourceContainerServiceImageRead(ctx, d, meta)
+}
+
+func resourceContainerServiceImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LightsailConn()
+
+	serviceName, image, err := ContainerServiceImageParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	containerImage, err := FindContainerImageByName(ctx, conn, serviceName, image)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lightsail Container Service (%s) Image (%s) not found, removing from state", serviceName, image)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Lightsail Container Service (%s) Image (%s): %s", serviceName, image, err)
+	}
+
+	d.Set("created_at", aws.TimeValue(containerImage.CreatedAt).Format(time.RFC3339))
+	d.Set("digest", containerImage.Digest)
+	d.Set("image", containerImage.Image)
+	d.Set("label", containerServiceImageLabel(serviceName, image))
+	d.Set("service_name", serviceName)
+
+	return nil
+}
+
+func resourceContainerServiceImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LightsailConn()
+
+	serviceName, image, err := ContainerServiceImageParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Lightsail Container Service (%s) Image: %s", serviceName, image)
+	_, err = conn.DeleteContainerImageWithContext(ctx, &lightsail.DeleteContainerImageInput{
+		Image:       aws.String(image),
+		ServiceName: aws.String(serviceName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Lightsail Container Service (%s) Image (%s): %s", serviceName, image, err)
+	}
+
+	return nil
+}
+
+func ContainerServiceImageCreateResourceID(serviceName, image string) string {
+	return fmt.Sprintf("%s/%s", serviceName, image)
+}
+
+func ContainerServiceImageParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected SERVICE_NAME/IMAGE", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// containerServiceImageLabel returns the label from a registered image name,
+// which has the form ":<service name>.<label>.<version>".
+func containerServiceImageLabel(serviceName, image string) string {
+	label := strings.TrimPrefix(image, fmt.Sprintf(":%s.", serviceName))
+
+	if i := strings.LastIndex(label, "."); i >= 0 {
+		label = label[:i]
+	}
+
+	return label
+}
@@ -0,0 +1,186 @@
+package lightsail_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestContainerServiceImageParseResourceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName            string
+		Input               string
+		ExpectedServiceName string
+		ExpectedImage       string
+		Error               bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Error:    true,
+		},
+		{
+			TestName: "Invalid ID",
+			Input:    "abcdefg12345678/",
+			Error:    true,
+		},
+		{
+			TestName: "Invalid ID with more than 1 separator",
+			Input:    "abcdefg12345678/qwerty09876/:abcdefg12345678.web.1",
+			Error:    true,
+		},
+		{
+			TestName:            "Valid ID",
+			Input:               "abcdefg12345678/:abcdefg12345678.web.1",
+			ExpectedServiceName: "abcdefg12345678",
+			ExpectedImage:       ":abcdefg12345678.web.1",
+			Error:               false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			gotServiceName, gotImage, err := tflightsail.ContainerServiceImageParseResourceID(testCase.Input)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (ServiceName: %s, Image: %s) and no error, expected error", gotServiceName, gotImage)
+			}
+
+			if gotServiceName != testCase.ExpectedServiceName {
+				t.Errorf("got %s, expected %s", gotServiceName, testCase.ExpectedServiceName)
+			}
+
+			if gotImage != testCase.ExpectedImage {
+				t.Errorf("got %s, expected %s", gotImage, testCase.ExpectedImage)
+			}
+		})
+	}
+}
+
+// Registering an image requires its layers to have been pushed to the
+// container service's registry, e.g. with the lightsailctl plugin.
+func TestAccLightsailContainerServiceImage_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	serviceName := os.Getenv("LIGHTSAIL_CONTAINER_SERVICE_NAME")
+	if serviceName == "" {
+		t.Skip("Environment variable LIGHTSAIL_CONTAINER_SERVICE_NAME is not set")
+	}
+	digest := os.Getenv("LIGHTSAIL_CONTAINER_IMAGE_DIGEST")
+	if digest == "" {
+		t.Skip("Environment variable LIGHTSAIL_CONTAINER_IMAGE_DIGEST is not set")
+	}
+	resourceName := "aws_lightsail_container_service_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lightsail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerServiceImageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerServiceImageConfig_basic(serviceName, digest, "tfacctest"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerServiceImageExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "digest", digest),
+					resource.TestMatchResourceAttr(resourceName, "image", regexp.MustCompile(fmt.Sprintf(`^:%s\.tfacctest\.\d+$`, serviceName))),
+					resource.TestCheckResourceAttr(resourceName, "label", "tfacctest"),
+					resource.TestCheckResourceAttr(resourceName, "service_name", serviceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckContainerServiceImageExists(ctx context.Context, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no Lightsail Container Service Image ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn()
+
+		serviceName, image, err := tflightsail.ContainerServiceImageParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tflightsail.FindContainerImageByName(ctx, conn, serviceName, image)
+
+		return err
+	}
+}
+
+func testAccCheckContainerServiceImageDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lightsail_container_service_image" {
+				continue
+			}
+
+			serviceName, image, err := tflightsail.ContainerServiceImageParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tflightsail.FindContainerImageByName(ctx, conn, serviceName, image)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lightsail Container Service Image %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccContainerServiceImageConfig_basic(serviceName, digest, label string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_container_service_image" "test" {
+  service_name = %[1]q
+  digest       = %[2]q
+  label        = %[3]q
+}
+`, serviceName, digest, label)
+}
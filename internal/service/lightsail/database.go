@@ -132,6 +132,28 @@ func ResourceDatabase() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z_]+$`), "Subsequent characters can be letters, underscores, or digits (0- 9)"),
 				),
 			},
+			"parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_method": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "pending-reboot",
+							ValidateFunc: validation.StringInSlice([]string{"immediate", "pending-reboot"}, false),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"preferred_backup_window": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -261,6 +283,13 @@ func resourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.Errorf("waiting for Lightsail Relational Database (%s) to become available: %s", d.Id(), err)
 	}
 
+	// Parameters cannot be passed on creation, so they are applied once the Database is available.
+	if v, ok := d.GetOk("parameter"); ok && v.(*schema.Set).Len() > 0 {
+		if err := updateDatabaseParameters(ctx, conn, d.Id(), expandDatabaseParameters(v.(*schema.Set).List())); err != nil {
+			return diag.Errorf("updating Lightsail Relational Database (%s) parameters: %s", d.Id(), err)
+		}
+	}
+
 	return resourceDatabaseRead(ctx, d, meta)
 }
 
@@ -300,6 +329,19 @@ func resourceDatabaseRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("master_endpoint_address", rd.MasterEndpoint.Address)
 	d.Set("master_endpoint_port", rd.MasterEndpoint.Port)
 	d.Set("master_username", rd.MasterUsername)
+
+	if v, ok := d.GetOk("parameter"); ok && v.(*schema.Set).Len() > 0 {
+		parameters, err := FindDatabaseParametersByName(ctx, conn, d.Id())
+
+		if err != nil {
+			return diag.Errorf("reading Lightsail Relational Database (%s) parameters: %s", d.Id(), err)
+		}
+
+		if err := d.Set("parameter", flattenDatabaseParameters(parameters, v.(*schema.Set).List())); err != nil {
+			return diag.Errorf("setting parameter: %s", err)
+		}
+	}
+
 	d.Set("preferred_backup_window", rd.PreferredBackupWindow)
 	d.Set("preferred_maintenance_window", rd.PreferredMaintenanceWindow)
 	d.Set("publicly_accessible", rd.PubliclyAccessible)
@@ -325,7 +367,7 @@ func resourceDatabaseRead(ctx context.Context, d *schema.ResourceData, meta inte
 func resourceDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).LightsailConn()
 
-	if d.HasChangesExcept("apply_immediately", "final_snapshot_name", "skip_final_snapshot", "tags", "tags_all") {
+	if d.HasChangesExcept("apply_immediately", "final_snapshot_name", "parameter", "skip_final_snapshot", "tags", "tags_all") {
 		input := &lightsail.UpdateRelationalDatabaseInput{
 			ApplyImmediately:       aws.Bool(d.Get("apply_immediately").(bool)),
 			RelationalDatabaseName: aws.String(d.Id()),
@@ -387,6 +429,18 @@ func resourceDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("parameter") {
+		o, n := d.GetChange("parameter")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Parameters removed from configuration keep their current value; only new or changed parameters are sent.
+		if parameters := expandDatabaseParameters(ns.Difference(os).List()); len(parameters) > 0 {
+			if err := updateDatabaseParameters(ctx, conn, d.Id(), parameters); err != nil {
+				return diag.Errorf("updating Lightsail Relational Database (%s) parameters: %s", d.Id(), err)
+			}
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -441,3 +495,85 @@ func resourceDatabaseImport(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set("skip_final_snapshot", true)
 	return []*schema.ResourceData{d}, nil
 }
+
+func updateDatabaseParameters(ctx context.Context, conn *lightsail.Lightsail, name string, parameters []*lightsail.RelationalDatabaseParameter) error {
+	input := &lightsail.UpdateRelationalDatabaseParametersInput{
+		Parameters:             parameters,
+		RelationalDatabaseName: aws.String(name),
+	}
+
+	output, err := conn.UpdateRelationalDatabaseParametersWithContext(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	if len(output.Operations) > 0 {
+		if err := waitOperationWithContext(ctx, conn, output.Operations[0].Id); err != nil {
+			return err
+		}
+	}
+
+	_, err = waitDatabaseModified(ctx, conn, aws.String(name))
+
+	return err
+}
+
+func expandDatabaseParameters(tfList []interface{}) []*lightsail.RelationalDatabaseParameter {
+	var apiObjects []*lightsail.RelationalDatabaseParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &lightsail.RelationalDatabaseParameter{
+			ApplyMethod:    aws.String(tfMap["apply_method"].(string)),
+			ParameterName:  aws.String(tfMap["name"].(string)),
+			ParameterValue: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+// flattenDatabaseParameters returns the current value of each configured parameter.
+// The apply method is not reported per-request by the API, so the configured value is kept.
+func flattenDatabaseParameters(apiObjects []*lightsail.RelationalDatabaseParameter, configured []interface{}) []interface{} {
+	values := make(map[string]string, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		values[aws.StringValue(apiObject.ParameterName)] = aws.StringValue(apiObject.ParameterValue)
+	}
+
+	var tfList []interface{}
+
+	for _, tfMapRaw := range configured {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+		value, ok := values[name]
+
+		if !ok {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"apply_method": tfMap["apply_method"].(string),
+			"name":         name,
+			"value":        value,
+		})
+	}
+
+	return tfList
+}
@@ -685,6 +685,50 @@ func TestAccLightsailDatabase_ha(t *testing.T) {
 	})
 }
 
+func TestAccLightsailDatabase_parameter(t *testing.T) {
+	ctx := acctest.Context(t)
+	var db lightsail.RelationalDatabase
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lightsail_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(lightsail.EndpointsID, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lightsail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatabaseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseConfig_parameter(rName, "50"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseExists(ctx, resourceName, &db),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"apply_method": "immediate",
+						"name":         "max_connect_errors",
+						"value":        "50",
+					}),
+				),
+			},
+			{
+				Config: testAccDatabaseConfig_parameter(rName, "100"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseExists(ctx, resourceName, &db),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"apply_method": "immediate",
+						"name":         "max_connect_errors",
+						"value":        "100",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLightsailDatabase_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var db lightsail.RelationalDatabase
@@ -1044,6 +1088,29 @@ resource "aws_lightsail_database" "test" {
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2))
 }
 
+func testAccDatabaseConfig_parameter(rName, value string) string {
+	return acctest.ConfigCompose(
+		testAccDatabaseConfig_base(),
+		fmt.Sprintf(`
+resource "aws_lightsail_database" "test" {
+  relational_database_name = %[1]q
+  availability_zone        = data.aws_availability_zones.available.names[0]
+  master_database_name     = "testdatabasename"
+  master_password          = "testdatabasepassword"
+  master_username          = "test"
+  blueprint_id             = "mysql_8_0"
+  bundle_id                = "micro_1_0"
+  skip_final_snapshot      = true
+
+  parameter {
+    name         = "max_connect_errors"
+    value        = %[2]q
+    apply_method = "immediate"
+  }
+}
+`, rName, value))
+}
+
 func testAccDatabaseConfig_ha(rName string) string {
 	return acctest.ConfigCompose(
 		testAccDatabaseConfig_base(),
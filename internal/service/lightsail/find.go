@@ -111,6 +111,40 @@ func FindContainerServiceDeploymentByVersion(ctx context.Context, conn *lightsai
 	return result, nil
 }
 
+func FindContainerImageByName(ctx context.Context, conn *lightsail.Lightsail, serviceName, image string) (*lightsail.ContainerImage, error) {
+	input := &lightsail.GetContainerImagesInput{
+		ServiceName: aws.String(serviceName),
+	}
+
+	output, err := conn.GetContainerImagesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.ContainerImages {
+		if v != nil && aws.StringValue(v.Image) == image {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message:     "Empty result",
+		LastRequest: input,
+	}
+}
+
 func FindDiskById(ctx context.Context, conn *lightsail.Lightsail, id string) (*lightsail.Disk, error) {
 	in := &lightsail.GetDiskInput{
 		DiskName: aws.String(id),
@@ -452,3 +486,114 @@ func FindInstanceById(ctx context.Context, conn *lightsail.Lightsail, id string)
 
 	return out.Instance, nil
 }
+
+func FindDatabaseParametersByName(ctx context.Context, conn *lightsail.Lightsail, name string) ([]*lightsail.RelationalDatabaseParameter, error) {
+	input := &lightsail.GetRelationalDatabaseParametersInput{
+		RelationalDatabaseName: aws.String(name),
+	}
+	var parameters []*lightsail.RelationalDatabaseParameter
+
+	for {
+		output, err := conn.GetRelationalDatabaseParametersWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		parameters = append(parameters, output.Parameters...)
+
+		if aws.StringValue(output.NextPageToken) == "" {
+			break
+		}
+
+		input.PageToken = output.NextPageToken
+	}
+
+	return parameters, nil
+}
+
+func FindBucketAccessKeyById(ctx context.Context, conn *lightsail.Lightsail, id string) (*lightsail.AccessKey, error) {
+	id_parts := strings.SplitN(id, ",", -1)
+	if len(id_parts) != 2 {
+		return nil, errors.New("invalid bucket access key id")
+	}
+
+	bucketName := id_parts[0]
+	accessKeyId := id_parts[1]
+
+	in := &lightsail.GetBucketAccessKeysInput{BucketName: aws.String(bucketName)}
+	out, err := conn.GetBucketAccessKeysWithContext(ctx, in)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	for _, v := range out.AccessKeys {
+		if v != nil && aws.StringValue(v.AccessKeyId) == accessKeyId {
+			return v, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(in)
+}
+
+func FindBucketResourceAccessById(ctx context.Context, conn *lightsail.Lightsail, id string) (*lightsail.ResourceReceivingAccess, error) {
+	id_parts := strings.SplitN(id, ",", -1)
+	if len(id_parts) != 2 {
+		return nil, errors.New("invalid bucket resource access id")
+	}
+
+	bucketName := id_parts[0]
+	resourceName := id_parts[1]
+
+	in := &lightsail.GetBucketsInput{
+		BucketName:                aws.String(bucketName),
+		IncludeConnectedResources: aws.Bool(true),
+	}
+	out, err := conn.GetBucketsWithContext(ctx, in)
+
+	if tfawserr.ErrCodeEquals(err, lightsail.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || len(out.Buckets) == 0 || out.Buckets[0] == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	for _, v := range out.Buckets[0].ResourcesReceivingAccess {
+		if v != nil && aws.StringValue(v.Name) == resourceName {
+			return v, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(in)
+}
@@ -0,0 +1,552 @@
+package location
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// BatchPutGeofence and BatchDeleteGeofence accept at most 10 entries per call.
+	geofencesBatchSize = 10
+)
+
+func ResourceGeofences() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGeofencesCreate,
+		ReadWithoutTimeout:   resourceGeofencesRead,
+		UpdateWithoutTimeout: resourceGeofencesUpdate,
+		DeleteWithoutTimeout: resourceGeofencesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"collection_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"geofence_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"geojson": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validGeofencesGeoJSON,
+				DiffSuppressFunc: suppressEquivalentGeofencesGeoJSON,
+			},
+		},
+	}
+}
+
+const (
+	ResNameGeofences = "Geofences"
+)
+
+func resourceGeofencesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn()
+
+	collectionName := d.Get("collection_name").(string)
+
+	geofences, err := expandGeofencesGeoJSON(d.Get("geojson").(string))
+	if err != nil {
+		return create.DiagError(names.Location, create.ErrActionCreating, ResNameGeofences, collectionName, err)
+	}
+
+	if err := putGeofences(ctx, conn, collectionName, geofences); err != nil {
+		return create.DiagError(names.Location, create.ErrActionCreating, ResNameGeofences, collectionName, err)
+	}
+
+	d.SetId(collectionName)
+
+	if err := waitGeofencesActive(ctx, conn, d.Id(), geofenceIDs(geofences), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.Location, create.ErrActionWaitingForCreation, ResNameGeofences, d.Id(), err)
+	}
+
+	d.Set("geofence_ids", geofenceIDs(geofences))
+
+	return resourceGeofencesRead(ctx, d, meta)
+}
+
+func resourceGeofencesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn()
+
+	// An imported resource takes ownership of every geofence in the collection.
+	var ids []string
+	if v, ok := d.GetOk("geofence_ids"); ok {
+		ids = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	out, err := findGeofencesByCollectionName(ctx, conn, d.Id(), ids)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location Geofences (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Location, create.ErrActionReading, ResNameGeofences, d.Id(), err)
+	}
+
+	geojson, err := flattenGeofencesGeoJSON(out)
+	if err != nil {
+		return create.DiagError(names.Location, create.ErrActionReading, ResNameGeofences, d.Id(), err)
+	}
+
+	d.Set("collection_name", d.Id())
+	d.Set("geofence_ids", listGeofenceIDs(out))
+	d.Set("geojson", geojson)
+
+	return nil
+}
+
+func resourceGeofencesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn()
+
+	if d.HasChange("geojson") {
+		o, n := d.GetChange("geojson")
+
+		// The old value has already been validated or was produced by Read.
+		oldGeofences, _ := expandGeofencesGeoJSON(o.(string))
+		newGeofences, err := expandGeofencesGeoJSON(n.(string))
+		if err != nil {
+			return create.DiagError(names.Location, create.ErrActionUpdating, ResNameGeofences, d.Id(), err)
+		}
+
+		oldByID := make(map[string]*locationservice.BatchPutGeofenceRequestEntry, len(oldGeofences))
+		for _, v := range oldGeofences {
+			oldByID[aws.StringValue(v.GeofenceId)] = v
+		}
+
+		var put []*locationservice.BatchPutGeofenceRequestEntry
+		for _, v := range newGeofences {
+			id := aws.StringValue(v.GeofenceId)
+			if old, ok := oldByID[id]; !ok || !reflect.DeepEqual(old.Geometry, v.Geometry) {
+				put = append(put, v)
+			}
+			delete(oldByID, id)
+		}
+
+		var del []string
+		for id := range oldByID {
+			del = append(del, id)
+		}
+
+		if err := deleteGeofences(ctx, conn, d.Id(), del); err != nil {
+			return create.DiagError(names.Location, create.ErrActionUpdating, ResNameGeofences, d.Id(), err)
+		}
+
+		if err := putGeofences(ctx, conn, d.Id(), put); err != nil {
+			return create.DiagError(names.Location, create.ErrActionUpdating, ResNameGeofences, d.Id(), err)
+		}
+
+		if err := waitGeofencesActive(ctx, conn, d.Id(), geofenceIDs(put), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.Location, create.ErrActionWaitingForUpdate, ResNameGeofences, d.Id(), err)
+		}
+
+		d.Set("geofence_ids", geofenceIDs(newGeofences))
+	}
+
+	return resourceGeofencesRead(ctx, d, meta)
+}
+
+func resourceGeofencesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn()
+
+	ids := flex.ExpandStringValueSet(d.Get("geofence_ids").(*schema.Set))
+
+	log.Printf("[INFO] Deleting Location Geofences %s: %v", d.Id(), ids)
+	err := deleteGeofences(ctx, conn, d.Id(), ids)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Location, create.ErrActionDeleting, ResNameGeofences, d.Id(), err)
+	}
+
+	return nil
+}
+
+func putGeofences(ctx context.Context, conn *locationservice.LocationService, collectionName string, geofences []*locationservice.BatchPutGeofenceRequestEntry) error {
+	var errs *multierror.Error
+
+	for _, chunk := range chunkGeofences(geofences) {
+		out, err := conn.BatchPutGeofenceWithContext(ctx, &locationservice.BatchPutGeofenceInput{
+			CollectionName: aws.String(collectionName),
+			Entries:        chunk,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range out.Errors {
+			errs = multierror.Append(errs, geofenceBatchItemError(aws.StringValue(v.GeofenceId), v.Error))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func deleteGeofences(ctx context.Context, conn *locationservice.LocationService, collectionName string, ids []string) error {
+	var errs *multierror.Error
+
+	for i := 0; i < len(ids); i += geofencesBatchSize {
+		j := i + geofencesBatchSize
+		if j > len(ids) {
+			j = len(ids)
+		}
+
+		out, err := conn.BatchDeleteGeofenceWithContext(ctx, &locationservice.BatchDeleteGeofenceInput{
+			CollectionName: aws.String(collectionName),
+			GeofenceIds:    aws.StringSlice(ids[i:j]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range out.Errors {
+			if aws.StringValue(v.Error.Code) == locationservice.BatchItemErrorCodeResourceNotFoundError {
+				continue
+			}
+
+			errs = multierror.Append(errs, geofenceBatchItemError(aws.StringValue(v.GeofenceId), v.Error))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func chunkGeofences(geofences []*locationservice.BatchPutGeofenceRequestEntry) [][]*locationservice.BatchPutGeofenceRequestEntry {
+	var chunks [][]*locationservice.BatchPutGeofenceRequestEntry
+
+	for i := 0; i < len(geofences); i += geofencesBatchSize {
+		j := i + geofencesBatchSize
+		if j > len(geofences) {
+			j = len(geofences)
+		}
+
+		chunks = append(chunks, geofences[i:j])
+	}
+
+	return chunks
+}
+
+func geofenceBatchItemError(id string, apiObject *locationservice.BatchItemError) error {
+	if apiObject == nil {
+		return fmt.Errorf("geofence (%s): unknown error", id)
+	}
+
+	return fmt.Errorf("geofence (%s): %s: %s", id, aws.StringValue(apiObject.Code), aws.StringValue(apiObject.Message))
+}
+
+func findGeofencesByCollectionName(ctx context.Context, conn *locationservice.LocationService, collectionName string, ids []string) ([]*locationservice.ListGeofenceResponseEntry, error) {
+	in := &locationservice.ListGeofencesInput{
+		CollectionName: aws.String(collectionName),
+	}
+
+	wanted := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	var out []*locationservice.ListGeofenceResponseEntry
+
+	err := conn.ListGeofencesPagesWithContext(ctx, in, func(page *locationservice.ListGeofencesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Entries {
+			// Only polygon geofences can be represented in GeoJSON.
+			if v == nil || v.Geometry == nil || v.Geometry.Polygon == nil {
+				continue
+			}
+
+			if status := aws.StringValue(v.Status); status == geofenceStatusDeleted || status == geofenceStatusDeleting {
+				continue
+			}
+
+			if len(wanted) > 0 && !wanted[aws.StringValue(v.GeofenceId)] {
+				continue
+			}
+
+			out = append(out, v)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out) == 0 {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+const (
+	geofenceStatusActive   = "ACTIVE"
+	geofenceStatusDeleted  = "DELETED"
+	geofenceStatusDeleting = "DELETING"
+	geofenceStatusFailed   = "FAILED"
+	geofenceStatusPending  = "PENDING"
+)
+
+// statusGeofences reports PENDING until every requested geofence has been indexed.
+func statusGeofences(ctx context.Context, conn *locationservice.LocationService, collectionName string, ids []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findGeofencesByCollectionName(ctx, conn, collectionName, ids)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(out) < len(ids) {
+			return out, geofenceStatusPending, nil
+		}
+
+		for _, v := range out {
+			switch status := aws.StringValue(v.Status); status {
+			case geofenceStatusActive:
+			case geofenceStatusFailed:
+				return out, status, fmt.Errorf("geofence (%s) failed to be indexed", aws.StringValue(v.GeofenceId))
+			default:
+				return out, status, nil
+			}
+		}
+
+		return out, geofenceStatusActive, nil
+	}
+}
+
+func waitGeofencesActive(ctx context.Context, conn *locationservice.LocationService, collectionName string, ids []string, timeout time.Duration) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{geofenceStatusPending},
+		Target:  []string{geofenceStatusActive},
+		Refresh: statusGeofences(ctx, conn, collectionName, ids),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+type geofencesFeatureCollection struct {
+	Type     string             `json:"type"`
+	Features []geofencesFeature `json:"features"`
+}
+
+type geofencesFeature struct {
+	Type       string                 `json:"type"`
+	ID         interface{}            `json:"id"`
+	Geometry   *geofencesGeometry     `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type geofencesGeometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// expandGeofencesGeoJSON parses a GeoJSON FeatureCollection whose features are
+// Polygons identified by a string "id" member.
+func expandGeofencesGeoJSON(s string) ([]*locationservice.BatchPutGeofenceRequestEntry, error) {
+	var fc geofencesFeatureCollection
+
+	if err := json.Unmarshal([]byte(s), &fc); err != nil {
+		return nil, fmt.Errorf("decoding GeoJSON: %w", err)
+	}
+
+	if fc.Type != "FeatureCollection" {
+		return nil, fmt.Errorf("GeoJSON type must be FeatureCollection, got %q", fc.Type)
+	}
+
+	if len(fc.Features) == 0 {
+		return nil, errors.New("GeoJSON FeatureCollection must contain at least one feature")
+	}
+
+	seen := make(map[string]bool, len(fc.Features))
+	var geofences []*locationservice.BatchPutGeofenceRequestEntry
+
+	for i, feature := range fc.Features {
+		id, ok := feature.ID.(string)
+		if !ok || id == "" {
+			return nil, fmt.Errorf("feature %d: id must be a non-empty string", i)
+		}
+
+		if seen[id] {
+			return nil, fmt.Errorf("feature %d: duplicate id %q", i, id)
+		}
+		seen[id] = true
+
+		if feature.Geometry == nil || feature.Geometry.Type != "Polygon" {
+			return nil, fmt.Errorf("feature (%s): geometry type must be Polygon", id)
+		}
+
+		var coordinates [][][]*float64
+		if err := json.Unmarshal(feature.Geometry.Coordinates, &coordinates); err != nil {
+			return nil, fmt.Errorf("feature (%s): decoding coordinates: %w", id, err)
+		}
+
+		if len(coordinates) == 0 {
+			return nil, fmt.Errorf("feature (%s): polygon must contain at least one linear ring", id)
+		}
+
+		geofences = append(geofences, &locationservice.BatchPutGeofenceRequestEntry{
+			GeofenceId: aws.String(id),
+			Geometry: &locationservice.GeofenceGeometry{
+				Polygon: coordinates,
+			},
+		})
+	}
+
+	return geofences, nil
+}
+
+func flattenGeofencesGeoJSON(apiObjects []*locationservice.ListGeofenceResponseEntry) (string, error) {
+	fc := geofencesFeatureCollection{
+		Type: "FeatureCollection",
+	}
+
+	for _, apiObject := range apiObjects {
+		coordinates, err := json.Marshal(apiObject.Geometry.Polygon)
+		if err != nil {
+			return "", err
+		}
+
+		fc.Features = append(fc.Features, geofencesFeature{
+			Type: "Feature",
+			ID:   aws.StringValue(apiObject.GeofenceId),
+			Geometry: &geofencesGeometry{
+				Type:        "Polygon",
+				Coordinates: coordinates,
+			},
+			Properties: map[string]interface{}{},
+		})
+	}
+
+	sort.Slice(fc.Features, func(i, j int) bool {
+		return fc.Features[i].ID.(string) < fc.Features[j].ID.(string)
+	})
+
+	b, err := json.Marshal(fc)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func geofenceIDs(apiObjects []*locationservice.BatchPutGeofenceRequestEntry) []string {
+	var ids []string
+
+	for _, apiObject := range apiObjects {
+		ids = append(ids, aws.StringValue(apiObject.GeofenceId))
+	}
+
+	return ids
+}
+
+func listGeofenceIDs(apiObjects []*locationservice.ListGeofenceResponseEntry) []string {
+	var ids []string
+
+	for _, apiObject := range apiObjects {
+		ids = append(ids, aws.StringValue(apiObject.GeofenceId))
+	}
+
+	return ids
+}
+
+func validGeofencesGeoJSON(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := expandGeofencesGeoJSON(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
+
+// suppressEquivalentGeofencesGeoJSON ignores feature order, formatting and any
+// members (such as properties) that are not stored by the service.
+func suppressEquivalentGeofencesGeoJSON(k, old, new string, d *schema.ResourceData) bool {
+	oldGeofences, err := expandGeofencesGeoJSON(old)
+	if err != nil {
+		return false
+	}
+
+	newGeofences, err := expandGeofencesGeoJSON(new)
+	if err != nil {
+		return false
+	}
+
+	if len(oldGeofences) != len(newGeofences) {
+		return false
+	}
+
+	oldByID := make(map[string]*locationservice.GeofenceGeometry, len(oldGeofences))
+	for _, v := range oldGeofences {
+		oldByID[aws.StringValue(v.GeofenceId)] = v.Geometry
+	}
+
+	for _, v := range newGeofences {
+		if old, ok := oldByID[aws.StringValue(v.GeofenceId)]; !ok || !reflect.DeepEqual(old, v.Geometry) {
+			return false
+		}
+	}
+
+	return true
+}
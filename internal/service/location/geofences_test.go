@@ -0,0 +1,285 @@
+package location_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflocation "github.com/hashicorp/terraform-provider-aws/internal/service/location"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLocationGeofences_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_geofences.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, locationservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGeofencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeofencesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "collection_name", "aws_location_geofence_collection.test", "collection_name"),
+					resource.TestCheckResourceAttr(resourceName, "geofence_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "geofence_ids.*", "fence-1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "geofence_ids.*", "fence-2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLocationGeofences_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_geofences.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, locationservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGeofencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeofencesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofencesExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflocation.ResourceGeofences(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLocationGeofences_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_geofences.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, locationservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGeofencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeofencesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "geofence_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccGeofencesConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "geofence_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "geofence_ids.*", "fence-2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "geofence_ids.*", "fence-3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLocationGeofences_invalidGeoJSON(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, locationservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGeofencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGeofencesConfig_point(rName),
+				ExpectError: regexp.MustCompile(`geometry type must be Polygon`),
+			},
+		},
+	})
+}
+
+func testAccCheckGeofencesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_location_geofences" {
+				continue
+			}
+
+			var found bool
+			err := conn.ListGeofencesPagesWithContext(ctx, &locationservice.ListGeofencesInput{
+				CollectionName: aws.String(rs.Primary.ID),
+			}, func(page *locationservice.ListGeofencesOutput, lastPage bool) bool {
+				for _, v := range page.Entries {
+					if status := aws.StringValue(v.Status); status != "DELETED" && status != "DELETING" {
+						found = true
+					}
+				}
+
+				return !lastPage
+			})
+
+			if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if found {
+				return create.Error(names.Location, create.ErrActionCheckingDestroyed, tflocation.ResNameGeofences, rs.Primary.ID, errors.New("not destroyed"))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGeofencesExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameGeofences, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameGeofences, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn()
+		output, err := conn.ListGeofencesWithContext(ctx, &locationservice.ListGeofencesInput{
+			CollectionName: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameGeofences, rs.Primary.ID, err)
+		}
+
+		if len(output.Entries) == 0 {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameGeofences, rs.Primary.ID, errors.New("no geofences"))
+		}
+
+		return nil
+	}
+}
+
+func testAccGeofencesConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_location_geofence_collection" "test" {
+  collection_name = %[1]q
+}
+`, rName)
+}
+
+func testAccGeofencesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGeofencesConfig_base(rName), `
+resource "aws_location_geofences" "test" {
+  collection_name = aws_location_geofence_collection.test.collection_name
+
+  geojson = jsonencode({
+    type = "FeatureCollection"
+    features = [
+      {
+        type       = "Feature"
+        id         = "fence-1"
+        properties = { name = "first" }
+        geometry = {
+          type        = "Polygon"
+          coordinates = [[[-5.716667, -15.933333], [-14.416667, -7.933333], [-12.316667, -37.066667], [-5.716667, -15.933333]]]
+        }
+      },
+      {
+        type       = "Feature"
+        id         = "fence-2"
+        properties = {}
+        geometry = {
+          type        = "Polygon"
+          coordinates = [[[10.0, 10.0], [11.0, 10.0], [11.0, 11.0], [10.0, 11.0], [10.0, 10.0]]]
+        }
+      },
+    ]
+  })
+}
+`)
+}
+
+func testAccGeofencesConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccGeofencesConfig_base(rName), `
+resource "aws_location_geofences" "test" {
+  collection_name = aws_location_geofence_collection.test.collection_name
+
+  geojson = jsonencode({
+    type = "FeatureCollection"
+    features = [
+      {
+        type       = "Feature"
+        id         = "fence-3"
+        properties = {}
+        geometry = {
+          type        = "Polygon"
+          coordinates = [[[20.0, 20.0], [21.0, 20.0], [21.0, 21.0], [20.0, 21.0], [20.0, 20.0]]]
+        }
+      },
+      {
+        type       = "Feature"
+        id         = "fence-2"
+        properties = {}
+        geometry = {
+          type        = "Polygon"
+          coordinates = [[[10.0, 10.0], [12.0, 10.0], [12.0, 12.0], [10.0, 12.0], [10.0, 10.0]]]
+        }
+      },
+    ]
+  })
+}
+`)
+}
+
+func testAccGeofencesConfig_point(rName string) string {
+	return acctest.ConfigCompose(testAccGeofencesConfig_base(rName), `
+resource "aws_location_geofences" "test" {
+  collection_name = aws_location_geofence_collection.test.collection_name
+
+  geojson = jsonencode({
+    type = "FeatureCollection"
+    features = [
+      {
+        type       = "Feature"
+        id         = "fence-1"
+        properties = {}
+        geometry = {
+          type        = "Point"
+          coordinates = [10.0, 10.0]
+        }
+      },
+    ]
+  })
+}
+`)
+}
@@ -57,6 +57,7 @@ func ResourceTracker() *schema.Resource {
 			"tracker_name": {
 				Type:         schema.TypeString,
 				Required:     true,
+				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
 			"update_time": {
@@ -0,0 +1,182 @@
+package managedblockchain
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceNode() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceNodeRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ethereum": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"http_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"websocket_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"fabric": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"peer_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peer_event_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"member_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNodeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn()
+
+	networkID := d.Get("network_id").(string)
+	nodeID := d.Get("node_id").(string)
+	input := &managedblockchain.GetNodeInput{
+		NetworkId: aws.String(networkID),
+		NodeId:    aws.String(nodeID),
+	}
+
+	if v, ok := d.GetOk("member_id"); ok {
+		input.MemberId = aws.String(v.(string))
+	}
+
+	node, err := findNode(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("reading Managed Blockchain Node (%s): %s", nodeID, err)
+	}
+
+	d.SetId(aws.StringValue(node.Id))
+	d.Set("arn", node.Arn)
+	d.Set("availability_zone", node.AvailabilityZone)
+	d.Set("creation_date", aws.TimeValue(node.CreationDate).Format(time.RFC3339))
+	d.Set("instance_type", node.InstanceType)
+	d.Set("member_id", node.MemberId)
+	d.Set("network_id", node.NetworkId)
+	d.Set("node_id", node.Id)
+	d.Set("status", node.Status)
+
+	var ethereum, fabric []interface{}
+	if v := node.FrameworkAttributes; v != nil {
+		ethereum = flattenNodeEthereumAttributes(v.Ethereum)
+		fabric = flattenNodeFabricAttributes(v.Fabric)
+	}
+
+	if err := d.Set("ethereum", ethereum); err != nil {
+		return diag.Errorf("setting ethereum: %s", err)
+	}
+
+	if err := d.Set("fabric", fabric); err != nil {
+		return diag.Errorf("setting fabric: %s", err)
+	}
+
+	return nil
+}
+
+func findNode(ctx context.Context, conn *managedblockchain.ManagedBlockchain, input *managedblockchain.GetNodeInput) (*managedblockchain.Node, error) {
+	output, err := conn.GetNodeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Node == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Node, nil
+}
+
+func flattenNodeEthereumAttributes(apiObject *managedblockchain.NodeEthereumAttributes) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"http_endpoint":      aws.StringValue(apiObject.HttpEndpoint),
+		"websocket_endpoint": aws.StringValue(apiObject.WebSocketEndpoint),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenNodeFabricAttributes(apiObject *managedblockchain.NodeFabricAttributes) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"peer_endpoint":       aws.StringValue(apiObject.PeerEndpoint),
+		"peer_event_endpoint": aws.StringValue(apiObject.PeerEventEndpoint),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,54 @@
+package managedblockchain_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+const (
+	envVarNetworkID             = "AWS_MANAGEDBLOCKCHAIN_NETWORK_ID"
+	envVarNetworkIDMessageError = "Environment variable AWS_MANAGEDBLOCKCHAIN_NETWORK_ID is not set. " +
+		"Managed Blockchain networks and nodes are not managed by this provider, so an existing network ID must be provided."
+
+	envVarNodeID             = "AWS_MANAGEDBLOCKCHAIN_NODE_ID"
+	envVarNodeIDMessageError = "Environment variable AWS_MANAGEDBLOCKCHAIN_NODE_ID is not set. " +
+		"Managed Blockchain networks and nodes are not managed by this provider, so an existing node ID must be provided."
+)
+
+func TestAccManagedBlockchainNodeDataSource_basic(t *testing.T) {
+	networkID := envvar.SkipIfEmpty(t, envVarNetworkID, envVarNetworkIDMessageError)
+	nodeID := envvar.SkipIfEmpty(t, envVarNodeID, envVarNodeIDMessageError)
+	dataSourceName := "data.aws_managedblockchain_node.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(managedblockchain.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, managedblockchain.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNodeDataSourceConfig_basic(networkID, nodeID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "network_id", networkID),
+					resource.TestCheckResourceAttr(dataSourceName, "node_id", nodeID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instance_type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNodeDataSourceConfig_basic(networkID, nodeID string) string {
+	return fmt.Sprintf(`
+data "aws_managedblockchain_node" "test" {
+  network_id = %[1]q
+  node_id    = %[2]q
+}
+`, networkID, nodeID)
+}
@@ -0,0 +1,366 @@
+package mediaconvert
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceJobTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobTemplateCreate,
+		ReadWithoutTimeout:   resourceJobTemplateRead,
+		UpdateWithoutTimeout: resourceJobTemplateUpdate,
+		DeleteWithoutTimeout: resourceJobTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acceleration_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(mediaconvert.AccelerationMode_Values(), false),
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"hop_destinations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(-50, 50),
+						},
+						"queue": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"wait_minutes": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(-50, 50),
+			},
+			"queue": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"settings": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					oldJSON, err := normalizeJobTemplateSettingsJSON(old)
+
+					if err != nil {
+						return false
+					}
+
+					newJSON, err := normalizeJobTemplateSettingsJSON(new)
+
+					if err != nil {
+						return false
+					}
+
+					return oldJSON == newJSON
+				},
+				StateFunc: func(v interface{}) string {
+					json, _ := normalizeJobTemplateSettingsJSON(v.(string))
+					return json
+				},
+			},
+			"status_update_interval": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(mediaconvert.StatusUpdateInterval_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceJobTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Media Convert Account Client: %s", err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &mediaconvert.CreateJobTemplateInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("acceleration_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AccelerationSettings = expandAccelerationSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("hop_destinations"); ok && len(v.([]interface{})) > 0 {
+		input.HopDestinations = expandHopDestinations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("priority"); ok {
+		input.Priority = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("queue"); ok {
+		input.Queue = aws.String(v.(string))
+	}
+
+	settings, err := expandJobTemplateSettings(d.Get("settings").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Media Convert Job Template (%s): %s", name, err)
+	}
+
+	input.Settings = settings
+
+	if v, ok := d.GetOk("status_update_interval"); ok {
+		input.StatusUpdateInterval = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateJobTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Media Convert Job Template (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.JobTemplate.Name))
+
+	return append(diags, resourceJobTemplateRead(ctx, d, meta)...)
+}
+
+func resourceJobTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Media Convert Account Client: %s", err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	jobTemplate, err := FindJobTemplateByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Media Convert Job Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Media Convert Job Template (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("acceleration_settings", flattenAccelerationSettings(jobTemplate.AccelerationSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting acceleration_settings: %s", err)
+	}
+	d.Set("arn", jobTemplate.Arn)
+	d.Set("category", jobTemplate.Category)
+	d.Set("description", jobTemplate.Description)
+	if err := d.Set("hop_destinations", flattenHopDestinations(jobTemplate.HopDestinations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting hop_destinations: %s", err)
+	}
+	d.Set("name", jobTemplate.Name)
+	d.Set("priority", jobTemplate.Priority)
+	d.Set("queue", jobTemplate.Queue)
+	settings, err := flattenSettings(jobTemplate.Settings)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Media Convert Job Template (%s): %s", d.Id(), err)
+	}
+	d.Set("settings", settings)
+	d.Set("status_update_interval", jobTemplate.StatusUpdateInterval)
+
+	tags, err := ListTags(ctx, conn, aws.StringValue(jobTemplate.Arn))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Media Convert Job Template (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceJobTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Media Convert Account Client: %s", err)
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &mediaconvert.UpdateJobTemplateInput{
+			Category:    aws.String(d.Get("category").(string)),
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+			Priority:    aws.Int64(int64(d.Get("priority").(int))),
+		}
+
+		if v, ok := d.GetOk("acceleration_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.AccelerationSettings = expandAccelerationSettings(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		// An empty list clears any previously configured hop destinations.
+		input.HopDestinations = []*mediaconvert.HopDestination{}
+		if v, ok := d.GetOk("hop_destinations"); ok && len(v.([]interface{})) > 0 {
+			input.HopDestinations = expandHopDestinations(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("queue"); ok {
+			input.Queue = aws.String(v.(string))
+		}
+
+		settings, err := expandJobTemplateSettings(d.Get("settings").(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Media Convert Job Template (%s): %s", d.Id(), err)
+		}
+
+		input.Settings = settings
+
+		if v, ok := d.GetOk("status_update_interval"); ok {
+			input.StatusUpdateInterval = aws.String(v.(string))
+		}
+
+		_, err = conn.UpdateJobTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Media Convert Job Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating tags: %s", err)
+		}
+	}
+
+	return append(diags, resourceJobTemplateRead(ctx, d, meta)...)
+}
+
+func resourceJobTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Media Convert Account Client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting Media Convert Job Template: %s", d.Id())
+	_, err = conn.DeleteJobTemplateWithContext(ctx, &mediaconvert.DeleteJobTemplateInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediaconvert.ErrCodeNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Media Convert Job Template (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindJobTemplateByName(ctx context.Context, conn *mediaconvert.MediaConvert, name string) (*mediaconvert.JobTemplate, error) {
+	input := &mediaconvert.GetJobTemplateInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetJobTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mediaconvert.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JobTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.JobTemplate, nil
+}
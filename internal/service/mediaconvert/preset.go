@@ -0,0 +1,265 @@
+package mediaconvert
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePreset() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePresetCreate,
+		ReadWithoutTimeout:   resourcePresetRead,
+		UpdateWithoutTimeout: resourcePresetUpdate,
+		DeleteWithoutTimeout: resourcePresetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"settings": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					oldJSON, err := normalizePresetSettingsJSON(old)
+
+					if err != nil {
+						return false
+					}
+
+					newJSON, err := normalizePresetSettingsJSON(new)
+
+					if err != nil {
+						return false
+					}
+
+					return oldJSON == newJSON
+				},
+				StateFunc: func(v interface{}) string {
+					json, _ := normalizePresetSettingsJSON(v.(string))
+					return json
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePresetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Media Convert Account Client: %s", err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &mediaconvert.CreatePresetInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	settings, err := expandPresetSettings(d.Get("settings").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Media Convert Preset (%s): %s", name, err)
+	}
+
+	input.Settings = settings
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreatePresetWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Media Convert Preset (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Preset.Name))
+
+	return append(diags, resourcePresetRead(ctx, d, meta)...)
+}
+
+func resourcePresetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Media Convert Account Client: %s", err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	preset, err := FindPresetByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Media Convert Preset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Media Convert Preset (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", preset.Arn)
+	d.Set("category", preset.Category)
+	d.Set("description", preset.Description)
+	d.Set("name", preset.Name)
+	settings, err := flattenSettings(preset.Settings)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Media Convert Preset (%s): %s", d.Id(), err)
+	}
+	d.Set("settings", settings)
+
+	tags, err := ListTags(ctx, conn, aws.StringValue(preset.Arn))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Media Convert Preset (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourcePresetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Media Convert Account Client: %s", err)
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &mediaconvert.UpdatePresetInput{
+			Category:    aws.String(d.Get("category").(string)),
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+		}
+
+		settings, err := expandPresetSettings(d.Get("settings").(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Media Convert Preset (%s): %s", d.Id(), err)
+		}
+
+		input.Settings = settings
+
+		_, err = conn.UpdatePresetWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Media Convert Preset (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating tags: %s", err)
+		}
+	}
+
+	return append(diags, resourcePresetRead(ctx, d, meta)...)
+}
+
+func resourcePresetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, err := GetAccountClient(meta.(*conns.AWSClient))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Media Convert Account Client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting Media Convert Preset: %s", d.Id())
+	_, err = conn.DeletePresetWithContext(ctx, &mediaconvert.DeletePresetInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediaconvert.ErrCodeNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Media Convert Preset (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindPresetByName(ctx context.Context, conn *mediaconvert.MediaConvert, name string) (*mediaconvert.Preset, error) {
+	input := &mediaconvert.GetPresetInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetPresetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mediaconvert.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Preset == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Preset, nil
+}
@@ -233,6 +233,32 @@ func resourceQueueDelete(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "getting Media Convert Account Client: %s", err)
 	}
 
+	// A reserved queue's commitment would otherwise renew automatically even though the queue
+	// is no longer managed, so switch it to expire at the end of the current term first.
+	if d.Get("pricing_plan").(string) == mediaconvert.PricingPlanReserved {
+		if v, ok := d.GetOk("reservation_plan_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			reservationPlanSettings := expandReservationPlanSettings(v.([]interface{})[0].(map[string]interface{}))
+
+			if aws.StringValue(reservationPlanSettings.RenewalType) == mediaconvert.RenewalTypeAutoRenew {
+				reservationPlanSettings.RenewalType = aws.String(mediaconvert.RenewalTypeExpire)
+
+				log.Printf("[DEBUG] Disabling automatic renewal of Media Convert Queue (%s) commitment", d.Id())
+				_, err = conn.UpdateQueueWithContext(ctx, &mediaconvert.UpdateQueueInput{
+					Name:                    aws.String(d.Id()),
+					ReservationPlanSettings: reservationPlanSettings,
+				})
+
+				if tfawserr.ErrCodeEquals(err, mediaconvert.ErrCodeNotFoundException) {
+					return diags
+				}
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "disabling automatic renewal of Media Convert Queue (%s) commitment: %s", d.Id(), err)
+				}
+			}
+		}
+	}
+
 	delOpts := &mediaconvert.DeleteQueueInput{
 		Name: aws.String(d.Id()),
 	}
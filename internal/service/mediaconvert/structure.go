@@ -1,7 +1,10 @@
 package mediaconvert
 
 import (
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
 )
 
@@ -36,3 +39,130 @@ func flattenReservationPlan(reservationPlan *mediaconvert.ReservationPlan) []int
 
 	return []interface{}{m}
 }
+
+func expandJobTemplateSettings(s string) (*mediaconvert.JobTemplateSettings, error) {
+	settings := &mediaconvert.JobTemplateSettings{}
+
+	if err := jsonutil.UnmarshalJSONCaseInsensitive(settings, strings.NewReader(s)); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func expandPresetSettings(s string) (*mediaconvert.PresetSettings, error) {
+	settings := &mediaconvert.PresetSettings{}
+
+	if err := jsonutil.UnmarshalJSONCaseInsensitive(settings, strings.NewReader(s)); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func flattenSettings(settings interface{}) (string, error) {
+	b, err := jsonutil.BuildJSON(settings)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// normalizeJobTemplateSettingsJSON returns the canonical JSON form of job template settings,
+// so that key casing and ordering differences do not show up as diffs.
+func normalizeJobTemplateSettingsJSON(s string) (string, error) {
+	settings, err := expandJobTemplateSettings(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	return flattenSettings(settings)
+}
+
+// normalizePresetSettingsJSON returns the canonical JSON form of preset settings.
+func normalizePresetSettingsJSON(s string) (string, error) {
+	settings, err := expandPresetSettings(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	return flattenSettings(settings)
+}
+
+func expandHopDestinations(tfList []interface{}) []*mediaconvert.HopDestination {
+	var apiObjects []*mediaconvert.HopDestination
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediaconvert.HopDestination{}
+
+		if v, ok := tfMap["priority"].(int); ok {
+			apiObject.Priority = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["queue"].(string); ok && v != "" {
+			apiObject.Queue = aws.String(v)
+		}
+
+		if v, ok := tfMap["wait_minutes"].(int); ok && v != 0 {
+			apiObject.WaitMinutes = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenHopDestinations(apiObjects []*mediaconvert.HopDestination) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"priority":     aws.Int64Value(apiObject.Priority),
+			"queue":        aws.StringValue(apiObject.Queue),
+			"wait_minutes": aws.Int64Value(apiObject.WaitMinutes),
+		})
+	}
+
+	return tfList
+}
+
+func expandAccelerationSettings(tfMap map[string]interface{}) *mediaconvert.AccelerationSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediaconvert.AccelerationSettings{}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		apiObject.Mode = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAccelerationSettings(apiObject *mediaconvert.AccelerationSettings) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"mode": aws.StringValue(apiObject.Mode),
+	}
+
+	return []interface{}{tfMap}
+}
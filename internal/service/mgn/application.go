@@ -0,0 +1,311 @@
+package mgn
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mgn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationCreate,
+		ReadWithoutTimeout:   resourceApplicationRead,
+		UpdateWithoutTimeout: resourceApplicationUpdate,
+		DeleteWithoutTimeout: resourceApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"archived": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 600),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"wave_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &mgn.CreateApplicationInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateApplicationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating MGN Application (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ApplicationID))
+
+	if v, ok := d.GetOk("wave_id"); ok {
+		if err := associateApplication(ctx, conn, d.Id(), v.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("archived").(bool) {
+		if err := archiveApplication(ctx, conn, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceApplicationRead(ctx, d, meta)
+}
+
+func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	application, err := FindApplicationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MGN Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading MGN Application (%s): %s", d.Id(), err)
+	}
+
+	d.Set("archived", application.IsArchived)
+	d.Set("arn", application.Arn)
+	d.Set("description", application.Description)
+	d.Set("name", application.Name)
+	d.Set("wave_id", application.WaveID)
+
+	tags := KeyValueTags(application.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	// Archived applications cannot be modified, so unarchive before other changes and archive after.
+	if d.HasChange("archived") && !d.Get("archived").(bool) {
+		if err := unarchiveApplication(ctx, conn, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChanges("description", "name") {
+		input := &mgn.UpdateApplicationInput{
+			Description:   aws.String(d.Get("description").(string)),
+			Name:          aws.String(d.Get("name").(string)),
+			ApplicationID: aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateApplicationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating MGN Application (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("wave_id") {
+		o, n := d.GetChange("wave_id")
+
+		if o := o.(string); o != "" {
+			if err := disassociateApplication(ctx, conn, d.Id(), o); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if n := n.(string); n != "" {
+			if err := associateApplication(ctx, conn, d.Id(), n); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating MGN Application (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("archived") && d.Get("archived").(bool) {
+		if err := archiveApplication(ctx, conn, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceApplicationRead(ctx, d, meta)
+}
+
+func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	log.Printf("[DEBUG] Deleting MGN Application: %s", d.Id())
+	_, err := conn.DeleteApplicationWithContext(ctx, &mgn.DeleteApplicationInput{
+		ApplicationID: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mgn.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting MGN Application (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func archiveApplication(ctx context.Context, conn *mgn.Mgn, id string) error {
+	_, err := conn.ArchiveApplicationWithContext(ctx, &mgn.ArchiveApplicationInput{
+		ApplicationID: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("archiving MGN Application (%s): %w", id, err)
+	}
+
+	return nil
+}
+
+func unarchiveApplication(ctx context.Context, conn *mgn.Mgn, id string) error {
+	_, err := conn.UnarchiveApplicationWithContext(ctx, &mgn.UnarchiveApplicationInput{
+		ApplicationID: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("unarchiving MGN Application (%s): %w", id, err)
+	}
+
+	return nil
+}
+
+func associateApplication(ctx context.Context, conn *mgn.Mgn, id, waveID string) error {
+	_, err := conn.AssociateApplicationsWithContext(ctx, &mgn.AssociateApplicationsInput{
+		ApplicationIDs: aws.StringSlice([]string{id}),
+		WaveID:         aws.String(waveID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("associating MGN Application (%s) with Wave (%s): %w", id, waveID, err)
+	}
+
+	return nil
+}
+
+func disassociateApplication(ctx context.Context, conn *mgn.Mgn, id, waveID string) error {
+	_, err := conn.DisassociateApplicationsWithContext(ctx, &mgn.DisassociateApplicationsInput{
+		ApplicationIDs: aws.StringSlice([]string{id}),
+		WaveID:         aws.String(waveID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("disassociating MGN Application (%s) from Wave (%s): %w", id, waveID, err)
+	}
+
+	return nil
+}
+
+func FindApplicationByID(ctx context.Context, conn *mgn.Mgn, id string) (*mgn.Application, error) {
+	input := &mgn.ListApplicationsInput{
+		Filters: &mgn.ListApplicationsRequestFilters{
+			ApplicationIDs: aws.StringSlice([]string{id}),
+		},
+	}
+
+	var output []*mgn.Application
+
+	err := conn.ListApplicationsPagesWithContext(ctx, input, func(page *mgn.ListApplicationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, mgn.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
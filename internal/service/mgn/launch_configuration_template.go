@@ -0,0 +1,733 @@
+package mgn
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mgn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLaunchConfigurationTemplate() *schema.Resource {
+	launchTemplateDiskConfSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"iops": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"throughput": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"volume_type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(mgn.VolumeType_Values(), false),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLaunchConfigurationTemplateCreate,
+		ReadWithoutTimeout:   resourceLaunchConfigurationTemplateRead,
+		UpdateWithoutTimeout: resourceLaunchConfigurationTemplateUpdate,
+		DeleteWithoutTimeout: resourceLaunchConfigurationTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associate_public_ip_address": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"boot_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(mgn.BootMode_Values(), false),
+			},
+			"copy_private_ip": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"copy_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"ec2_launch_template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enable_map_auto_tagging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"large_volume_conf": launchTemplateDiskConfSchema(),
+			"launch_disposition": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(mgn.LaunchDisposition_Values(), false),
+			},
+			"licensing": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"os_byol": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"map_auto_tagging_mpe_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"post_launch_actions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloud_watch_log_group_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 512),
+						},
+						"deployment": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mgn.PostLaunchActionsDeploymentType_Values(), false),
+						},
+						"s3_log_bucket": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+						"s3_output_key_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ssm_document": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"must_succeed_for_cutover": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+									"parameter": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"parameter_store_parameter": {
+													Type:     schema.TypeList,
+													Required: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"parameter_name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"parameter_type": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(mgn.SsmParameterStoreParameterType_Values(), false),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"ssm_document_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(3, 172),
+									},
+									"timeout_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"small_volume_conf": launchTemplateDiskConfSchema(),
+			"small_volume_max_size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target_instance_type_right_sizing_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(mgn.TargetInstanceTypeRightSizingMethod_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceLaunchConfigurationTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &mgn.CreateLaunchConfigurationTemplateInput{}
+
+	if v, ok := d.GetOkExists("associate_public_ip_address"); ok {
+		input.AssociatePublicIpAddress = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("boot_mode"); ok {
+		input.BootMode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("copy_private_ip"); ok {
+		input.CopyPrivateIp = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOkExists("copy_tags"); ok {
+		input.CopyTags = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOkExists("enable_map_auto_tagging"); ok {
+		input.EnableMapAutoTagging = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("large_volume_conf"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LargeVolumeConf = expandLaunchTemplateDiskConf(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("launch_disposition"); ok {
+		input.LaunchDisposition = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("licensing"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Licensing = expandLicensing(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("map_auto_tagging_mpe_id"); ok {
+		input.MapAutoTaggingMpeID = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("post_launch_actions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PostLaunchActions = expandPostLaunchActions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("small_volume_conf"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SmallVolumeConf = expandLaunchTemplateDiskConf(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("small_volume_max_size"); ok {
+		input.SmallVolumeMaxSize = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("target_instance_type_right_sizing_method"); ok {
+		input.TargetInstanceTypeRightSizingMethod = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateLaunchConfigurationTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating MGN Launch Configuration Template: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.LaunchConfigurationTemplateID))
+
+	return resourceLaunchConfigurationTemplateRead(ctx, d, meta)
+}
+
+func resourceLaunchConfigurationTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	template, err := FindLaunchConfigurationTemplateByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MGN Launch Configuration Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading MGN Launch Configuration Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", template.Arn)
+	d.Set("associate_public_ip_address", template.AssociatePublicIpAddress)
+	d.Set("boot_mode", template.BootMode)
+	d.Set("copy_private_ip", template.CopyPrivateIp)
+	d.Set("copy_tags", template.CopyTags)
+	d.Set("ec2_launch_template_id", template.Ec2LaunchTemplateID)
+	d.Set("enable_map_auto_tagging", template.EnableMapAutoTagging)
+	if err := d.Set("large_volume_conf", flattenLaunchTemplateDiskConf(template.LargeVolumeConf)); err != nil {
+		return diag.Errorf("setting large_volume_conf: %s", err)
+	}
+	d.Set("launch_disposition", template.LaunchDisposition)
+	if err := d.Set("licensing", flattenLicensing(template.Licensing)); err != nil {
+		return diag.Errorf("setting licensing: %s", err)
+	}
+	d.Set("map_auto_tagging_mpe_id", template.MapAutoTaggingMpeID)
+	if err := d.Set("post_launch_actions", flattenPostLaunchActions(template.PostLaunchActions)); err != nil {
+		return diag.Errorf("setting post_launch_actions: %s", err)
+	}
+	if err := d.Set("small_volume_conf", flattenLaunchTemplateDiskConf(template.SmallVolumeConf)); err != nil {
+		return diag.Errorf("setting small_volume_conf: %s", err)
+	}
+	d.Set("small_volume_max_size", template.SmallVolumeMaxSize)
+	d.Set("target_instance_type_right_sizing_method", template.TargetInstanceTypeRightSizingMethod)
+
+	tags := KeyValueTags(template.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLaunchConfigurationTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &mgn.UpdateLaunchConfigurationTemplateInput{
+			LaunchConfigurationTemplateID: aws.String(d.Id()),
+		}
+
+		if d.HasChange("associate_public_ip_address") {
+			input.AssociatePublicIpAddress = aws.Bool(d.Get("associate_public_ip_address").(bool))
+		}
+
+		if d.HasChange("boot_mode") {
+			input.BootMode = aws.String(d.Get("boot_mode").(string))
+		}
+
+		if d.HasChange("copy_private_ip") {
+			input.CopyPrivateIp = aws.Bool(d.Get("copy_private_ip").(bool))
+		}
+
+		if d.HasChange("copy_tags") {
+			input.CopyTags = aws.Bool(d.Get("copy_tags").(bool))
+		}
+
+		if d.HasChange("enable_map_auto_tagging") {
+			input.EnableMapAutoTagging = aws.Bool(d.Get("enable_map_auto_tagging").(bool))
+		}
+
+		if d.HasChange("large_volume_conf") {
+			if v, ok := d.GetOk("large_volume_conf"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.LargeVolumeConf = expandLaunchTemplateDiskConf(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("launch_disposition") {
+			input.LaunchDisposition = aws.String(d.Get("launch_disposition").(string))
+		}
+
+		if d.HasChange("licensing") {
+			if v, ok := d.GetOk("licensing"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Licensing = expandLicensing(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("map_auto_tagging_mpe_id") {
+			input.MapAutoTaggingMpeID = aws.String(d.Get("map_auto_tagging_mpe_id").(string))
+		}
+
+		if d.HasChange("post_launch_actions") {
+			if v, ok := d.GetOk("post_launch_actions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.PostLaunchActions = expandPostLaunchActions(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.PostLaunchActions = &mgn.PostLaunchActions{}
+			}
+		}
+
+		if d.HasChange("small_volume_conf") {
+			if v, ok := d.GetOk("small_volume_conf"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.SmallVolumeConf = expandLaunchTemplateDiskConf(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("small_volume_max_size") {
+			input.SmallVolumeMaxSize = aws.Int64(int64(d.Get("small_volume_max_size").(int)))
+		}
+
+		if d.HasChange("target_instance_type_right_sizing_method") {
+			input.TargetInstanceTypeRightSizingMethod = aws.String(d.Get("target_instance_type_right_sizing_method").(string))
+		}
+
+		_, err := conn.UpdateLaunchConfigurationTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating MGN Launch Configuration Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating MGN Launch Configuration Template (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLaunchConfigurationTemplateRead(ctx, d, meta)
+}
+
+func resourceLaunchConfigurationTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	log.Printf("[DEBUG] Deleting MGN Launch Configuration Template: %s", d.Id())
+	_, err := conn.DeleteLaunchConfigurationTemplateWithContext(ctx, &mgn.DeleteLaunchConfigurationTemplateInput{
+		LaunchConfigurationTemplateID: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mgn.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting MGN Launch Configuration Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindLaunchConfigurationTemplateByID(ctx context.Context, conn *mgn.Mgn, id string) (*mgn.LaunchConfigurationTemplate, error) {
+	input := &mgn.DescribeLaunchConfigurationTemplatesInput{
+		LaunchConfigurationTemplateIDs: aws.StringSlice([]string{id}),
+	}
+
+	var output []*mgn.LaunchConfigurationTemplate
+
+	err := conn.DescribeLaunchConfigurationTemplatesPagesWithContext(ctx, input, func(page *mgn.DescribeLaunchConfigurationTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, mgn.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func expandLaunchTemplateDiskConf(tfMap map[string]interface{}) *mgn.LaunchTemplateDiskConf {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mgn.LaunchTemplateDiskConf{}
+
+	if v, ok := tfMap["iops"].(int); ok && v != 0 {
+		apiObject.Iops = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["throughput"].(int); ok && v != 0 {
+		apiObject.Throughput = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["volume_type"].(string); ok && v != "" {
+		apiObject.VolumeType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLicensing(tfMap map[string]interface{}) *mgn.Licensing {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mgn.Licensing{}
+
+	if v, ok := tfMap["os_byol"].(bool); ok {
+		apiObject.OsByol = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandPostLaunchActions(tfMap map[string]interface{}) *mgn.PostLaunchActions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mgn.PostLaunchActions{}
+
+	if v, ok := tfMap["cloud_watch_log_group_name"].(string); ok && v != "" {
+		apiObject.CloudWatchLogGroupName = aws.String(v)
+	}
+
+	if v, ok := tfMap["deployment"].(string); ok && v != "" {
+		apiObject.Deployment = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_log_bucket"].(string); ok && v != "" {
+		apiObject.S3LogBucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_output_key_prefix"].(string); ok && v != "" {
+		apiObject.S3OutputKeyPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["ssm_document"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SsmDocuments = expandSsmDocuments(v)
+	}
+
+	return apiObject
+}
+
+func expandSsmDocuments(tfList []interface{}) []*mgn.SsmDocument {
+	var apiObjects []*mgn.SsmDocument
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mgn.SsmDocument{}
+
+		if v, ok := tfMap["action_name"].(string); ok && v != "" {
+			apiObject.ActionName = aws.String(v)
+		}
+
+		if v, ok := tfMap["must_succeed_for_cutover"].(bool); ok {
+			apiObject.MustSucceedForCutover = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["parameter"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Parameters = expandSsmDocumentParameters(v.List())
+		}
+
+		if v, ok := tfMap["ssm_document_name"].(string); ok && v != "" {
+			apiObject.SsmDocumentName = aws.String(v)
+		}
+
+		if v, ok := tfMap["timeout_seconds"].(int); ok && v != 0 {
+			apiObject.TimeoutSeconds = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSsmDocumentParameters(tfList []interface{}) map[string][]*mgn.SsmParameterStoreParameter {
+	apiObjects := make(map[string][]*mgn.SsmParameterStoreParameter)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+
+		for _, tfMapRaw := range tfMap["parameter_store_parameter"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObjects[name] = append(apiObjects[name], &mgn.SsmParameterStoreParameter{
+				ParameterName: aws.String(tfMap["parameter_name"].(string)),
+				ParameterType: aws.String(tfMap["parameter_type"].(string)),
+			})
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenLaunchTemplateDiskConf(apiObject *mgn.LaunchTemplateDiskConf) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"iops":        aws.Int64Value(apiObject.Iops),
+		"throughput":  aws.Int64Value(apiObject.Throughput),
+		"volume_type": aws.StringValue(apiObject.VolumeType),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenLicensing(apiObject *mgn.Licensing) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"os_byol": aws.BoolValue(apiObject.OsByol),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenPostLaunchActions(apiObject *mgn.PostLaunchActions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"cloud_watch_log_group_name": aws.StringValue(apiObject.CloudWatchLogGroupName),
+		"deployment":                 aws.StringValue(apiObject.Deployment),
+		"s3_log_bucket":              aws.StringValue(apiObject.S3LogBucket),
+		"s3_output_key_prefix":       aws.StringValue(apiObject.S3OutputKeyPrefix),
+		"ssm_document":               flattenSsmDocuments(apiObject.SsmDocuments),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSsmDocuments(apiObjects []*mgn.SsmDocument) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"action_name":              aws.StringValue(apiObject.ActionName),
+			"must_succeed_for_cutover": aws.BoolValue(apiObject.MustSucceedForCutover),
+			"parameter":                flattenSsmDocumentParameters(apiObject.Parameters),
+			"ssm_document_name":        aws.StringValue(apiObject.SsmDocumentName),
+			"timeout_seconds":          aws.Int64Value(apiObject.TimeoutSeconds),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenSsmDocumentParameters(apiObjects map[string][]*mgn.SsmParameterStoreParameter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for name, apiObjects := range apiObjects {
+		var parameters []interface{}
+
+		for _, apiObject := range apiObjects {
+			if apiObject == nil {
+				continue
+			}
+
+			parameters = append(parameters, map[string]interface{}{
+				"parameter_name": aws.StringValue(apiObject.ParameterName),
+				"parameter_type": aws.StringValue(apiObject.ParameterType),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":                      name,
+			"parameter_store_parameter": parameters,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,198 @@
+package mgn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mgn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmgn "github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMGNLaunchConfigurationTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mgn.LaunchConfigurationTemplate
+	resourceName := "aws_mgn_launch_configuration_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(mgn.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mgn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "boot_mode", "LEGACY_BIOS"),
+					resource.TestCheckResourceAttr(resourceName, "copy_private_ip", "false"),
+					resource.TestCheckResourceAttr(resourceName, "copy_tags", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "ec2_launch_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "launch_disposition", "STARTED"),
+					resource.TestCheckResourceAttr(resourceName, "licensing.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "licensing.0.os_byol", "true"),
+					resource.TestCheckResourceAttr(resourceName, "target_instance_type_right_sizing_method", "BASIC"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMGNLaunchConfigurationTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mgn.LaunchConfigurationTemplate
+	resourceName := "aws_mgn_launch_configuration_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(mgn.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mgn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmgn.ResourceLaunchConfigurationTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMGNLaunchConfigurationTemplate_postLaunchActions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mgn.LaunchConfigurationTemplate
+	resourceName := "aws_mgn_launch_configuration_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(mgn.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mgn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_postLaunchActions("TEST_AND_CUTOVER", 300),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.0.deployment", "TEST_AND_CUTOVER"),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.0.ssm_document.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.0.ssm_document.0.action_name", "example"),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.0.ssm_document.0.must_succeed_for_cutover", "true"),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.0.ssm_document.0.ssm_document_name", "AWS-RunShellScript"),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.0.ssm_document.0.timeout_seconds", "300"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_postLaunchActions("CUTOVER_ONLY", 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.0.deployment", "CUTOVER_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_actions.0.ssm_document.0.timeout_seconds", "600"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLaunchConfigurationTemplateExists(ctx context.Context, n string, v *mgn.LaunchConfigurationTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MGN Launch Configuration Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MgnConn()
+
+		output, err := tfmgn.FindLaunchConfigurationTemplateByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckLaunchConfigurationTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MgnConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mgn_launch_configuration_template" {
+				continue
+			}
+
+			_, err := tfmgn.FindLaunchConfigurationTemplateByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MGN Launch Configuration Template %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccLaunchConfigurationTemplateConfig_basic() string {
+	return `
+resource "aws_mgn_launch_configuration_template" "test" {
+  boot_mode                                = "LEGACY_BIOS"
+  copy_private_ip                          = false
+  copy_tags                                = true
+  launch_disposition                       = "STARTED"
+  target_instance_type_right_sizing_method = "BASIC"
+
+  licensing {
+    os_byol = true
+  }
+}
+`
+}
+
+func testAccLaunchConfigurationTemplateConfig_postLaunchActions(deployment string, timeoutSeconds int) string {
+	return fmt.Sprintf(`
+resource "aws_mgn_launch_configuration_template" "test" {
+  post_launch_actions {
+    deployment = %[1]q
+
+    ssm_document {
+      action_name              = "example"
+      must_succeed_for_cutover = true
+      ssm_document_name        = "AWS-RunShellScript"
+      timeout_seconds          = %[2]d
+    }
+  }
+}
+`, deployment, timeoutSeconds)
+}
@@ -0,0 +1,258 @@
+package mgn
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mgn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceWave() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWaveCreate,
+		ReadWithoutTimeout:   resourceWaveRead,
+		UpdateWithoutTimeout: resourceWaveUpdate,
+		DeleteWithoutTimeout: resourceWaveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"archived": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 600),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceWaveCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &mgn.CreateWaveInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateWaveWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating MGN Wave (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.WaveID))
+
+	if d.Get("archived").(bool) {
+		if err := archiveWave(ctx, conn, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceWaveRead(ctx, d, meta)
+}
+
+func resourceWaveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	wave, err := FindWaveByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MGN Wave (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading MGN Wave (%s): %s", d.Id(), err)
+	}
+
+	d.Set("archived", wave.IsArchived)
+	d.Set("arn", wave.Arn)
+	d.Set("description", wave.Description)
+	d.Set("name", wave.Name)
+
+	tags := KeyValueTags(wave.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceWaveUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	// Archived waves cannot be modified, so unarchive before other changes and archive after.
+	if d.HasChange("archived") && !d.Get("archived").(bool) {
+		if err := unarchiveWave(ctx, conn, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChanges("description", "name") {
+		input := &mgn.UpdateWaveInput{
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Get("name").(string)),
+			WaveID:      aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateWaveWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating MGN Wave (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating MGN Wave (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("archived") && d.Get("archived").(bool) {
+		if err := archiveWave(ctx, conn, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceWaveRead(ctx, d, meta)
+}
+
+func resourceWaveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	log.Printf("[DEBUG] Deleting MGN Wave: %s", d.Id())
+	_, err := conn.DeleteWaveWithContext(ctx, &mgn.DeleteWaveInput{
+		WaveID: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mgn.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting MGN Wave (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func archiveWave(ctx context.Context, conn *mgn.Mgn, id string) error {
+	_, err := conn.ArchiveWaveWithContext(ctx, &mgn.ArchiveWaveInput{
+		WaveID: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("archiving MGN Wave (%s): %w", id, err)
+	}
+
+	return nil
+}
+
+func unarchiveWave(ctx context.Context, conn *mgn.Mgn, id string) error {
+	_, err := conn.UnarchiveWaveWithContext(ctx, &mgn.UnarchiveWaveInput{
+		WaveID: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("unarchiving MGN Wave (%s): %w", id, err)
+	}
+
+	return nil
+}
+
+func FindWaveByID(ctx context.Context, conn *mgn.Mgn, id string) (*mgn.Wave, error) {
+	input := &mgn.ListWavesInput{
+		Filters: &mgn.ListWavesRequestFilters{
+			WaveIDs: aws.StringSlice([]string{id}),
+		},
+	}
+
+	var output []*mgn.Wave
+
+	err := conn.ListWavesPagesWithContext(ctx, input, func(page *mgn.ListWavesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, mgn.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
@@ -2,6 +2,7 @@ package mwaa
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -106,6 +107,10 @@ func ResourceEnvironment() *schema.Resource {
 								},
 							},
 						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"status": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -641,6 +646,15 @@ func waitEnvironmentUpdated(ctx context.Context, conn *mwaa.MWAA, name string, t
 			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.LastUpdate.Error.ErrorCode), aws.StringValue(v.LastUpdate.Error.ErrorMessage)))
 		}
 
+		// A failed update (e.g. an Airflow version upgrade) is rolled back and the environment returns to AVAILABLE.
+		if err == nil && v.LastUpdate != nil && aws.StringValue(v.LastUpdate.Status) == mwaa.UpdateStatusFailed {
+			err = errors.New("update failed and was rolled back")
+
+			if v.LastUpdate.Error != nil {
+				err = fmt.Errorf("update failed and was rolled back: %s: %s", aws.StringValue(v.LastUpdate.Error.ErrorCode), aws.StringValue(v.LastUpdate.Error.ErrorMessage))
+			}
+		}
+
 		return v, err
 	}
 
@@ -746,6 +760,10 @@ func flattenLastUpdate(lastUpdate *mwaa.LastUpdate) []interface{} {
 		m["error"] = flattenLastUpdateError(lastUpdate.Error)
 	}
 
+	if lastUpdate.Source != nil {
+		m["source"] = lastUpdate.Source
+	}
+
 	if lastUpdate.Status != nil {
 		m["status"] = lastUpdate.Status
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mwaa"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -308,6 +309,45 @@ func TestAccMWAAEnvironment_full(t *testing.T) {
 	})
 }
 
+func TestAccMWAAEnvironment_updateAirflowVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var environment1, environment2 mwaa.Environment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mwaa_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mwaa.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_airflowVersion(rName, "2.2.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &environment1),
+					resource.TestCheckResourceAttr(resourceName, "airflow_version", "2.2.2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEnvironmentConfig_airflowVersion(rName, "2.4.3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &environment2),
+					testAccCheckEnvironmentNotRecreated(&environment1, &environment2),
+					resource.TestCheckResourceAttr(resourceName, "airflow_version", "2.4.3"),
+					resource.TestCheckResourceAttr(resourceName, "last_updated.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated.0.source"),
+					resource.TestCheckResourceAttr(resourceName, "last_updated.0.status", mwaa.UpdateStatusSuccess),
+				),
+			},
+		},
+	})
+}
+
 func TestAccMWAAEnvironment_pluginsS3ObjectVersion(t *testing.T) {
 	ctx := acctest.Context(t)
 	var environment mwaa.Environment
@@ -374,6 +414,16 @@ func testAccCheckEnvironmentExists(ctx context.Context, n string, v *mwaa.Enviro
 	}
 }
 
+func testAccCheckEnvironmentNotRecreated(i, j *mwaa.Environment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !aws.TimeValue(i.CreatedAt).Equal(aws.TimeValue(j.CreatedAt)) {
+			return fmt.Errorf("MWAA Environment (%s) recreated", aws.StringValue(i.Name))
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckEnvironmentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).MWAAConn()
@@ -689,6 +739,24 @@ resource "aws_mwaa_environment" "test" {
 `, rName, logEnabled, logLevel))
 }
 
+func testAccEnvironmentConfig_airflowVersion(rName, airflowVersion string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_mwaa_environment" "test" {
+  airflow_version    = %[2]q
+  dag_s3_path        = aws_s3_object.dags.key
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.private[*].id
+  }
+
+  source_bucket_arn = aws_s3_bucket.test.arn
+}
+`, rName, airflowVersion))
+}
+
 func testAccEnvironmentConfig_full(rName string) string {
 	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
 resource "aws_mwaa_environment" "test" {
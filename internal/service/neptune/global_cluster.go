@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceGlobalCluster() *schema.Resource {
@@ -103,6 +104,12 @@ func ResourceGlobalCluster() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"writer_db_cluster_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 		},
 	}
 }
@@ -187,6 +194,7 @@ func resourceGlobalClusterRead(ctx context.Context, d *schema.ResourceData, meta
 
 	d.Set("global_cluster_resource_id", globalCluster.GlobalClusterResourceId)
 	d.Set("storage_encrypted", globalCluster.StorageEncrypted)
+	d.Set("writer_db_cluster_arn", globalClusterWriterARN(globalCluster.GlobalClusterMembers))
 
 	return nil
 }
@@ -221,6 +229,24 @@ func resourceGlobalClusterUpdate(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(fmt.Errorf("error waiting for Neptune Global Cluster (%s) update: %w", d.Id(), err))
 	}
 
+	if d.HasChange("writer_db_cluster_arn") {
+		if o, n := d.GetChange("writer_db_cluster_arn"); o.(string) != "" && n.(string) != "" {
+			input := &neptune.FailoverGlobalClusterInput{
+				GlobalClusterIdentifier:   aws.String(d.Id()),
+				TargetDbClusterIdentifier: aws.String(n.(string)),
+			}
+
+			log.Printf("[DEBUG] Failing over Neptune Global Cluster (%s) to %s", d.Id(), n)
+			if _, err := conn.FailoverGlobalClusterWithContext(ctx, input); err != nil {
+				return diag.FromErr(fmt.Errorf("error failing over Neptune Global Cluster (%s) to %s: %w", d.Id(), n, err))
+			}
+
+			if err := waitForGlobalClusterFailover(ctx, conn, d.Id(), n.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(fmt.Errorf("error waiting for Neptune Global Cluster (%s) failover: %w", d.Id(), err))
+			}
+		}
+	}
+
 	return resourceGlobalClusterRead(ctx, d, meta)
 }
 
@@ -315,6 +341,16 @@ func flattenGlobalClusterMembers(apiObjects []*neptune.GlobalClusterMember) []in
 	return tfList
 }
 
+func globalClusterWriterARN(apiObjects []*neptune.GlobalClusterMember) string {
+	for _, apiObject := range apiObjects {
+		if aws.BoolValue(apiObject.IsWriter) {
+			return aws.StringValue(apiObject.DBClusterArn)
+		}
+	}
+
+	return ""
+}
+
 func statusGlobalClusterRefreshFunc(ctx context.Context, conn *neptune.Neptune, globalClusterID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		globalCluster, err := FindGlobalClusterById(ctx, conn, globalClusterID)
@@ -360,6 +396,31 @@ func waitForGlobalClusterUpdate(ctx context.Context, conn *neptune.Neptune, glob
 	return err
 }
 
+func waitForGlobalClusterFailover(ctx context.Context, conn *neptune.Neptune, globalClusterID, writerARN string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{GlobalClusterStatusFailingOver, GlobalClusterStatusModifying},
+		Target:  []string{GlobalClusterStatusAvailable},
+		Refresh: statusGlobalClusterRefreshFunc(ctx, conn, globalClusterID),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for Neptune Global Cluster (%s) failover", globalClusterID)
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	if output, ok := outputRaw.(*neptune.GlobalCluster); ok {
+		if v := globalClusterWriterARN(output.GlobalClusterMembers); v != writerARN {
+			return fmt.Errorf("writer is %s, expected %s", v, writerARN)
+		}
+	}
+
+	return nil
+}
+
 func waitForGlobalClusterRemoval(ctx context.Context, conn *neptune.Neptune, dbClusterIdentifier string, timeout time.Duration) error {
 	var globalCluster *neptune.GlobalCluster
 	stillExistsErr := errors.New(ErrClusterStillAttachedToGlobalCluster)
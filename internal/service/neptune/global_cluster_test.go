@@ -302,6 +302,47 @@ func TestAccNeptuneGlobalCluster_StorageEncrypted(t *testing.T) {
 	})
 }
 
+func TestAccNeptuneGlobalCluster_failover(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var globalCluster1, globalCluster2 neptune.GlobalCluster
+	rNameGlobal := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNamePrimary := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameSecondary := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_neptune_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheckGlobalCluster(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckGlobalClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalClusterConfig_failover(rNameGlobal, rNamePrimary, rNameSecondary, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(ctx, resourceName, &globalCluster1),
+					resource.TestCheckResourceAttrPair(resourceName, "writer_db_cluster_arn", "aws_neptune_cluster.primary", "arn"),
+				),
+			},
+			{
+				Config: testAccGlobalClusterConfig_failover(rNameGlobal, rNamePrimary, rNameSecondary, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(ctx, resourceName, &globalCluster2),
+					testAccCheckGlobalClusterNotRecreated(&globalCluster1, &globalCluster2),
+					resource.TestCheckResourceAttrPair(resourceName, "writer_db_cluster_arn", "aws_neptune_cluster.secondary", "arn"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGlobalClusterExists(ctx context.Context, resourceName string, globalCluster *neptune.GlobalCluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -535,3 +576,70 @@ resource "aws_neptune_global_cluster" "test" {
 }
 `, rName, storageEncrypted)
 }
+
+func testAccGlobalClusterConfig_failover(rNameGlobal, rNamePrimary, rNameSecondary string, failover bool) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(2),
+		fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+locals {
+  secondary_arn = "arn:${data.aws_partition.current.partition}:rds:${data.aws_region.alternate.name}:${data.aws_caller_identity.current.account_id}:cluster:%[3]s"
+}
+
+resource "aws_neptune_global_cluster" "test" {
+  engine                    = "neptune"
+  engine_version            = "1.2.0.0"
+  global_cluster_identifier = %[1]q
+  writer_db_cluster_arn     = %[4]t ? local.secondary_arn : null
+}
+
+resource "aws_neptune_cluster" "primary" {
+  cluster_identifier                   = %[2]q
+  engine                               = aws_neptune_global_cluster.test.engine
+  engine_version                       = aws_neptune_global_cluster.test.engine_version
+  global_cluster_identifier            = aws_neptune_global_cluster.test.id
+  neptune_cluster_parameter_group_name = "default.neptune1.2"
+  skip_final_snapshot                  = true
+}
+
+resource "aws_neptune_cluster_instance" "primary" {
+  cluster_identifier           = aws_neptune_cluster.primary.id
+  engine                       = aws_neptune_cluster.primary.engine
+  engine_version               = aws_neptune_cluster.primary.engine_version
+  identifier                   = %[2]q
+  instance_class               = "db.r5.large"
+  neptune_parameter_group_name = "default.neptune1.2"
+}
+
+resource "aws_neptune_cluster" "secondary" {
+  provider = "awsalternate"
+
+  cluster_identifier                   = %[3]q
+  engine                               = aws_neptune_global_cluster.test.engine
+  engine_version                       = aws_neptune_global_cluster.test.engine_version
+  global_cluster_identifier            = aws_neptune_global_cluster.test.id
+  neptune_cluster_parameter_group_name = "default.neptune1.2"
+  skip_final_snapshot                  = true
+
+  depends_on = [aws_neptune_cluster_instance.primary]
+}
+
+resource "aws_neptune_cluster_instance" "secondary" {
+  provider = "awsalternate"
+
+  cluster_identifier           = aws_neptune_cluster.secondary.id
+  engine                       = aws_neptune_cluster.secondary.engine
+  engine_version               = aws_neptune_cluster.secondary.engine_version
+  identifier                   = %[3]q
+  instance_class               = "db.r5.large"
+  neptune_parameter_group_name = "default.neptune1.2"
+}
+`, rNameGlobal, rNamePrimary, rNameSecondary, failover))
+}
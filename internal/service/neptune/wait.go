@@ -135,12 +135,13 @@ const (
 )
 
 const (
-	GlobalClusterStatusAvailable = "available"
-	GlobalClusterStatusCreating  = "creating"
-	GlobalClusterStatusDeleted   = "deleted"
-	GlobalClusterStatusDeleting  = "deleting"
-	GlobalClusterStatusModifying = "modifying"
-	GlobalClusterStatusUpgrading = "upgrading"
+	GlobalClusterStatusAvailable   = "available"
+	GlobalClusterStatusCreating    = "creating"
+	GlobalClusterStatusDeleted     = "deleted"
+	GlobalClusterStatusDeleting    = "deleting"
+	GlobalClusterStatusFailingOver = "failing-over"
+	GlobalClusterStatusModifying   = "modifying"
+	GlobalClusterStatusUpgrading   = "upgrading"
 )
 
 func WaitForGlobalClusterDeletion(ctx context.Context, conn *neptune.Neptune, globalClusterID string, timeout time.Duration) error {
@@ -0,0 +1,88 @@
+package opensearch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourceCompatibleVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCompatibleVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"compatible_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_versions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceCompatibleVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn()
+
+	input := &opensearchservice.GetCompatibleVersionsInput{}
+
+	if v, ok := d.GetOk("domain_name"); ok {
+		input.DomainName = aws.String(v.(string))
+	}
+
+	output, err := conn.GetCompatibleVersionsWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Compatible Versions: %s", err)
+	}
+
+	if v, ok := d.GetOk("domain_name"); ok {
+		d.SetId(v.(string))
+	} else {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	if err := d.Set("compatible_versions", flattenCompatibleVersionsMaps(output.CompatibleVersions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting compatible_versions: %s", err)
+	}
+
+	return diags
+}
+
+func flattenCompatibleVersionsMaps(apiObjects []*opensearchservice.CompatibleVersionsMap) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"source_version":  aws.StringValue(apiObject.SourceVersion),
+			"target_versions": aws.StringValueSlice(apiObject.TargetVersions),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,32 @@
+package opensearch_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccOpenSearchCompatibleVersionsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_opensearch_compatible_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCompatibleVersionsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "compatible_versions.#", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "compatible_versions.0.source_version"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCompatibleVersionsDataSourceConfig_basic = `
+data "aws_opensearch_compatible_versions" "test" {}
+`
@@ -0,0 +1,166 @@
+package opensearch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourceReservedInstanceOfferings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceReservedInstanceOfferingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(opensearchservice.OpenSearchPartitionInstanceType_Values(), false),
+			},
+			"payment_option": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(opensearchservice.ReservedInstancePaymentOption_Values(), false),
+			},
+			"reserved_instance_offerings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"currency_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"duration": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"fixed_price": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"payment_option": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"recurring_charges": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"recurring_charge_amount": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"recurring_charge_frequency": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"reserved_instance_offering_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"usage_price": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceReservedInstanceOfferingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn()
+
+	instanceType := d.Get("instance_type").(string)
+	paymentOption := d.Get("payment_option").(string)
+	input := &opensearchservice.DescribeReservedInstanceOfferingsInput{}
+	var offerings []*opensearchservice.ReservedInstanceOffering
+
+	err := conn.DescribeReservedInstanceOfferingsPagesWithContext(ctx, input, func(page *opensearchservice.DescribeReservedInstanceOfferingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ReservedInstanceOfferings {
+			if v == nil {
+				continue
+			}
+
+			if instanceType != "" && aws.StringValue(v.InstanceType) != instanceType {
+				continue
+			}
+
+			if paymentOption != "" && aws.StringValue(v.PaymentOption) != paymentOption {
+				continue
+			}
+
+			offerings = append(offerings, v)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Reserved Instance Offerings: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("reserved_instance_offerings", flattenReservedInstanceOfferings(offerings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting reserved_instance_offerings: %s", err)
+	}
+
+	return diags
+}
+
+func flattenReservedInstanceOfferings(apiObjects []*opensearchservice.ReservedInstanceOffering) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"currency_code":                 aws.StringValue(apiObject.CurrencyCode),
+			"duration":                      aws.Int64Value(apiObject.Duration),
+			"fixed_price":                   aws.Float64Value(apiObject.FixedPrice),
+			"instance_type":                 aws.StringValue(apiObject.InstanceType),
+			"payment_option":                aws.StringValue(apiObject.PaymentOption),
+			"recurring_charges":             flattenRecurringCharges(apiObject.RecurringCharges),
+			"reserved_instance_offering_id": aws.StringValue(apiObject.ReservedInstanceOfferingId),
+			"usage_price":                   aws.Float64Value(apiObject.UsagePrice),
+		})
+	}
+
+	return tfList
+}
+
+func flattenRecurringCharges(apiObjects []*opensearchservice.RecurringCharge) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"recurring_charge_amount":    aws.Float64Value(apiObject.RecurringChargeAmount),
+			"recurring_charge_frequency": aws.StringValue(apiObject.RecurringChargeFrequency),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,37 @@
+package opensearch_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccOpenSearchReservedInstanceOfferingsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_opensearch_reserved_instance_offerings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReservedInstanceOfferingsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "reserved_instance_offerings.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "reserved_instance_offerings.0.instance_type", "r6g.large.search"),
+					resource.TestCheckResourceAttr(dataSourceName, "reserved_instance_offerings.0.payment_option", "NO_UPFRONT"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "reserved_instance_offerings.0.reserved_instance_offering_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccReservedInstanceOfferingsDataSourceConfig_basic = `
+data "aws_opensearch_reserved_instance_offerings" "test" {
+  instance_type  = "r6g.large.search"
+  payment_option = "NO_UPFRONT"
+}
+`
@@ -0,0 +1,168 @@
+package outposts
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourceOrder() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceOrderRead,
+
+		Schema: map[string]*schema.Schema{
+			"line_items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asset_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"catalog_item_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"line_item_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"quantity": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"shipment_carrier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"shipment_tracking_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"order_fulfilled_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"order_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"order_submission_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"outpost_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"payment_option": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceOrderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OutpostsConn()
+
+	orderID := d.Get("order_id").(string)
+	output, err := conn.GetOrderWithContext(ctx, &outposts.GetOrderInput{
+		OrderId: aws.String(orderID),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Outposts Order (%s): %s", orderID, err)
+	}
+
+	if output == nil || output.Order == nil {
+		return sdkdiag.AppendErrorf(diags, "reading Outposts Order (%s): empty response", orderID)
+	}
+
+	order := output.Order
+
+	d.SetId(aws.StringValue(order.OrderId))
+	if err := d.Set("line_items", flattenLineItems(order.LineItems)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting line_items: %s", err)
+	}
+	if v := order.OrderFulfilledDate; v != nil {
+		d.Set("order_fulfilled_date", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("order_fulfilled_date", nil)
+	}
+	d.Set("order_id", order.OrderId)
+	if v := order.OrderSubmissionDate; v != nil {
+		d.Set("order_submission_date", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("order_submission_date", nil)
+	}
+	d.Set("outpost_id", order.OutpostId)
+	d.Set("payment_option", order.PaymentOption)
+	d.Set("status", order.Status)
+
+	return diags
+}
+
+func flattenLineItem(apiObject *outposts.LineItem) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var assetIDs []string
+	for _, v := range apiObject.AssetInformationList {
+		if v == nil {
+			continue
+		}
+
+		assetIDs = append(assetIDs, aws.StringValue(v.AssetId))
+	}
+
+	tfMap := map[string]interface{}{
+		"asset_ids":       assetIDs,
+		"catalog_item_id": aws.StringValue(apiObject.CatalogItemId),
+		"line_item_id":    aws.StringValue(apiObject.LineItemId),
+		"quantity":        aws.Int64Value(apiObject.Quantity),
+		"status":          aws.StringValue(apiObject.Status),
+	}
+
+	if v := apiObject.ShipmentInformation; v != nil {
+		tfMap["shipment_carrier"] = aws.StringValue(v.ShipmentCarrier)
+		tfMap["shipment_tracking_number"] = aws.StringValue(v.ShipmentTrackingNumber)
+	}
+
+	return tfMap
+}
+
+func flattenLineItems(apiObjects []*outposts.LineItem) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenLineItem(apiObject))
+	}
+
+	return tfList
+}
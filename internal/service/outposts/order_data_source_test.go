@@ -0,0 +1,44 @@
+package outposts_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccOutpostsOrderDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_outposts_order.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckOrders(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, outposts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrderDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "id", regexp.MustCompile(`^oo-.+$`)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "order_id", dataSourceName, "id"),
+					resource.TestMatchResourceAttr(dataSourceName, "outpost_id", regexp.MustCompile(`^op-.+$`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "payment_option"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrderDataSourceConfig_basic() string {
+	return `
+data "aws_outposts_orders" "test" {}
+
+data "aws_outposts_order" "test" {
+  order_id = tolist(data.aws_outposts_orders.test.ids)[0]
+}
+`
+}
@@ -0,0 +1,71 @@
+package outposts
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourceOrders() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceOrdersRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"outpost_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceOrdersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OutpostsConn()
+
+	input := &outposts.ListOrdersInput{}
+
+	if v, ok := d.GetOk("outpost_identifier"); ok {
+		input.OutpostIdentifierFilter = aws.String(v.(string))
+	}
+
+	var ids []string
+
+	err := conn.ListOrdersPagesWithContext(ctx, input, func(page *outposts.ListOrdersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, order := range page.Orders {
+			if order == nil {
+				continue
+			}
+
+			ids = append(ids, aws.StringValue(order.OrderId))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Outposts Orders: %s", err)
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ids: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	return diags
+}
@@ -0,0 +1,75 @@
+package outposts_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccOutpostsOrdersDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_outposts_orders.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckOrders(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, outposts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrdersDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrdersAttributes(dataSourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrdersAttributes(dataSourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", dataSourceName)
+		}
+
+		if v := rs.Primary.Attributes["ids.#"]; v == "0" {
+			return fmt.Errorf("expected at least one ids result, got none")
+		}
+
+		return nil
+	}
+}
+
+func testAccPreCheckOrders(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OutpostsConn()
+
+	input := &outposts.ListOrdersInput{}
+
+	output, err := conn.ListOrdersWithContext(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+
+	// Ensure there is at least one Order
+	if output == nil || len(output.Orders) == 0 {
+		t.Skip("skipping since no Outposts Order found")
+	}
+}
+
+func testAccOrdersDataSourceConfig_basic() string {
+	return `
+data "aws_outposts_orders" "test" {}
+`
+}
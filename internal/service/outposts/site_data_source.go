@@ -20,6 +20,10 @@ func DataSourceSite() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -36,6 +40,22 @@ func DataSourceSite() *schema.Resource {
 				Computed:     true,
 				ExactlyOneOf: []string{"id", "name"},
 			},
+			"notes": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operating_address_city": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operating_address_country_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operating_address_state_or_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -88,8 +108,13 @@ func dataSourceSiteRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.SetId(aws.StringValue(site.SiteId))
 	d.Set("account_id", site.AccountId)
+	d.Set("arn", site.SiteArn)
 	d.Set("description", site.Description)
 	d.Set("name", site.Name)
+	d.Set("notes", site.Notes)
+	d.Set("operating_address_city", site.OperatingAddressCity)
+	d.Set("operating_address_country_code", site.OperatingAddressCountryCode)
+	d.Set("operating_address_state_or_region", site.OperatingAddressStateOrRegion)
 
 	return diags
 }
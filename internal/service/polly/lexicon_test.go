@@ -0,0 +1,170 @@
+package polly_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/polly"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpolly "github.com/hashicorp/terraform-provider-aws/internal/service/polly"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPollyLexicon_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_polly_lexicon.test"
+	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, polly.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLexiconDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLexiconConfig_basic(rName, "W3C", "World Wide Web Consortium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alphabet", "ipa"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "polly", regexp.MustCompile(`lexicon/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "language_code", "en-US"),
+					resource.TestCheckResourceAttr(resourceName, "lexemes_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "size"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPollyLexicon_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_polly_lexicon.test"
+	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, polly.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLexiconDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLexiconConfig_basic(rName, "W3C", "World Wide Web Consortium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfpolly.ResourceLexicon(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPollyLexicon_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_polly_lexicon.test"
+	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, polly.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLexiconDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLexiconConfig_basic(rName, "W3C", "World Wide Web Consortium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName),
+					resource.TestMatchResourceAttr(resourceName, "content", regexp.MustCompile(`World Wide Web Consortium`)),
+				),
+			},
+			{
+				Config: testAccLexiconConfig_basic(rName, "AWS", "Amazon Web Services"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName),
+					resource.TestMatchResourceAttr(resourceName, "content", regexp.MustCompile(`Amazon Web Services`)),
+					resource.TestCheckResourceAttr(resourceName, "lexemes_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLexiconDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PollyConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_polly_lexicon" {
+				continue
+			}
+
+			_, err := tfpolly.FindLexiconByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Polly Lexicon %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLexiconExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Polly Lexicon ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PollyConn()
+
+		_, err := tfpolly.FindLexiconByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccLexiconConfig_basic(rName, grapheme, alias string) string {
+	return fmt.Sprintf(`
+resource "aws_polly_lexicon" "test" {
+  name = %[1]q
+
+  content = <<EOF
+<?xml version="1.0" encoding="UTF-8"?>
+<lexicon version="1.0"
+      xmlns="http://www.w3.org/2005/01/pronunciation-lexicon"
+      xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+      xsi:schemaLocation="http://www.w3.org/2005/01/pronunciation-lexicon
+        http://www.w3.org/TR/2007/CR-pronunciation-lexicon-20071212/pls.xsd"
+      alphabet="ipa" xml:lang="en-US">
+  <lexeme>
+    <grapheme>%[2]s</grapheme>
+    <alias>%[3]s</alias>
+  </lexeme>
+</lexicon>
+EOF
+}
+`, rName, grapheme, alias)
+}
@@ -0,0 +1,355 @@
+package qldb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceJournalS3Export() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJournalS3ExportCreate,
+		ReadWithoutTimeout:   resourceJournalS3ExportRead,
+		DeleteWithoutTimeout: resourceJournalS3ExportDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"exclusive_end_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"export_creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inclusive_start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"ledger_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 32),
+				),
+			},
+			"output_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(qldb.OutputFormat_Values(), false),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"s3_export_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 255),
+						},
+						"encryption_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kms_key_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"object_encryption_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(qldb.S3ObjectEncryptionType_Values(), false),
+									},
+								},
+							},
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceJournalS3ExportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QLDBConn()
+
+	ledgerName := d.Get("ledger_name").(string)
+	input := &qldb.ExportJournalToS3Input{
+		Name:    aws.String(ledgerName),
+		RoleArn: aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("exclusive_end_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.ExclusiveEndTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("inclusive_start_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.InclusiveStartTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("output_format"); ok {
+		input.OutputFormat = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3_export_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.S3ExportConfiguration = expandS3ExportConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating QLDB Journal S3 Export: %s", input)
+	output, err := conn.ExportJournalToS3WithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating QLDB Journal S3 Export (%s): %s", ledgerName, err)
+	}
+
+	d.SetId(JournalS3ExportCreateResourceID(ledgerName, aws.StringValue(output.ExportId)))
+
+	if _, err := waitJournalS3ExportCompleted(ctx, conn, ledgerName, aws.StringValue(output.ExportId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for QLDB Journal S3 Export (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceJournalS3ExportRead(ctx, d, meta)
+}
+
+func resourceJournalS3ExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QLDBConn()
+
+	ledgerName, exportID, err := JournalS3ExportParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	export, err := FindJournalS3Export(ctx, conn, ledgerName, exportID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QLDB Journal S3 Export %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading QLDB Journal S3 Export (%s): %s", d.Id(), err)
+	}
+
+	// QLDB moves an inclusive start time that precedes the ledger's creation time forward,
+	// so only populate the export window from the API on import.
+	if _, ok := d.GetOk("exclusive_end_time"); !ok {
+		d.Set("exclusive_end_time", aws.TimeValue(export.ExclusiveEndTime).Format(time.RFC3339))
+	}
+	d.Set("export_creation_time", aws.TimeValue(export.ExportCreationTime).Format(time.RFC3339))
+	d.Set("export_id", export.ExportId)
+	if _, ok := d.GetOk("inclusive_start_time"); !ok {
+		d.Set("inclusive_start_time", aws.TimeValue(export.InclusiveStartTime).Format(time.RFC3339))
+	}
+	d.Set("ledger_name", export.LedgerName)
+	d.Set("output_format", export.OutputFormat)
+	d.Set("role_arn", export.RoleArn)
+	if export.S3ExportConfiguration != nil {
+		if err := d.Set("s3_export_configuration", []interface{}{flattenS3ExportConfiguration(export.S3ExportConfiguration)}); err != nil {
+			return diag.Errorf("setting s3_export_configuration: %s", err)
+		}
+	} else {
+		d.Set("s3_export_configuration", nil)
+	}
+	d.Set("status", export.Status)
+
+	return nil
+}
+
+func resourceJournalS3ExportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Journal exports cannot be deleted. The exported objects remain in S3.
+	log.Printf("[WARN] QLDB Journal S3 Export (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+const journalS3ExportResourceIDSeparator = ","
+
+func JournalS3ExportCreateResourceID(ledgerName, exportID string) string {
+	parts := []string{ledgerName, exportID}
+	id := strings.Join(parts, journalS3ExportResourceIDSeparator)
+
+	return id
+}
+
+func JournalS3ExportParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, journalS3ExportResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected LedgerName%[2]sExportID", id, journalS3ExportResourceIDSeparator)
+}
+
+func FindJournalS3Export(ctx context.Context, conn *qldb.QLDB, ledgerName, exportID string) (*qldb.JournalS3ExportDescription, error) {
+	input := &qldb.DescribeJournalS3ExportInput{
+		ExportId: aws.String(exportID),
+		Name:     aws.String(ledgerName),
+	}
+
+	output, err := conn.DescribeJournalS3ExportWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, qldb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ExportDescription == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ExportDescription, nil
+}
+
+func statusJournalS3Export(ctx context.Context, conn *qldb.QLDB, ledgerName, exportID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindJournalS3Export(ctx, conn, ledgerName, exportID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitJournalS3ExportCompleted(ctx context.Context, conn *qldb.QLDB, ledgerName, exportID string, timeout time.Duration) (*qldb.JournalS3ExportDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{qldb.ExportStatusInProgress},
+		Target:     []string{qldb.ExportStatusCompleted},
+		Refresh:    statusJournalS3Export(ctx, conn, ledgerName, exportID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qldb.JournalS3ExportDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandS3ExportConfiguration(tfMap map[string]interface{}) *qldb.S3ExportConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &qldb.S3ExportConfiguration{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		encryptionConfiguration := &qldb.S3EncryptionConfiguration{}
+
+		if v, ok := tfMap["kms_key_arn"].(string); ok && v != "" {
+			encryptionConfiguration.KmsKeyArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["object_encryption_type"].(string); ok && v != "" {
+			encryptionConfiguration.ObjectEncryptionType = aws.String(v)
+		}
+
+		apiObject.EncryptionConfiguration = encryptionConfiguration
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3ExportConfiguration(apiObject *qldb.S3ExportConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket": aws.StringValue(apiObject.Bucket),
+		"prefix": aws.StringValue(apiObject.Prefix),
+	}
+
+	if v := apiObject.EncryptionConfiguration; v != nil {
+		tfMap["encryption_configuration"] = []interface{}{map[string]interface{}{
+			"kms_key_arn":            aws.StringValue(v.KmsKeyArn),
+			"object_encryption_type": aws.StringValue(v.ObjectEncryptionType),
+		}}
+	}
+
+	return tfMap
+}
@@ -202,11 +202,11 @@ func resourceStreamRead(ctx context.Context, d *schema.ResourceData, meta interf
 func resourceStreamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).QLDBConn()
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
-			return diag.Errorf("updating tags: %s", err)
+			return diag.Errorf("updating QLDB Stream (%s) tags: %s", d.Id(), err)
 		}
 	}
 
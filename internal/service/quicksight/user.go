@@ -37,6 +37,12 @@ func ResourceUser() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"custom_permissions_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+
 			"email": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -116,6 +122,10 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		UserRole:     aws.String(d.Get("user_role").(string)),
 	}
 
+	if v, ok := d.GetOk("custom_permissions_name"); ok {
+		createOpts.CustomPermissionsName = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("iam_arn"); ok {
 		createOpts.IamArn = aws.String(v.(string))
 	}
@@ -165,6 +175,7 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	d.Set("arn", resp.User.Arn)
 	d.Set("aws_account_id", awsAccountID)
+	d.Set("custom_permissions_name", resp.User.CustomPermissionsName)
 	d.Set("email", resp.User.Email)
 	d.Set("namespace", namespace)
 	d.Set("user_role", resp.User.Role)
@@ -190,6 +201,12 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		UserName:     aws.String(userName),
 	}
 
+	if v, ok := d.GetOk("custom_permissions_name"); ok {
+		updateOpts.CustomPermissionsName = aws.String(v.(string))
+	} else if d.HasChange("custom_permissions_name") {
+		updateOpts.UnapplyCustomPermissions = aws.Bool(true)
+	}
+
 	_, err = conn.UpdateUserWithContext(ctx, updateOpts)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating QuickSight User (%s): %s", d.Id(), err)
@@ -112,6 +112,42 @@ func TestAccQuickSightUser_withNamespace(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightUser_customPermissions(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "QUICKSIGHT_CUSTOM_PERMISSIONS_NAME"
+	customPermissionsName := os.Getenv(key)
+	if customPermissionsName == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var user quicksight.User
+	rName := "tfacctest" + sdkacctest.RandString(10)
+	resourceName := "aws_quicksight_user." + rName
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_customPermissions(rName, customPermissionsName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "custom_permissions_name", customPermissionsName),
+				),
+			},
+			{
+				Config: testAccUserConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "custom_permissions_name", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccQuickSightUser_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var user quicksight.User
@@ -262,3 +298,18 @@ resource "aws_quicksight_user" %[1]q {
 func testAccUserConfig_basic(rName string) string {
 	return testAccUserConfig_email(rName, acctest.DefaultEmailAddress)
 }
+
+func testAccUserConfig_customPermissions(rName, customPermissionsName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_quicksight_user" %[1]q {
+  aws_account_id          = data.aws_caller_identity.current.account_id
+  custom_permissions_name = %[3]q
+  user_name               = %[1]q
+  email                   = %[2]q
+  identity_type           = "QUICKSIGHT"
+  user_role               = "READER"
+}
+`, rName, acctest.DefaultEmailAddress, customPermissionsName)
+}
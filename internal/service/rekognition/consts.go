@@ -0,0 +1,17 @@
+package rekognition
+
+const (
+	connectedHomeLabelAll     = "ALL"
+	connectedHomeLabelPackage = "PACKAGE"
+	connectedHomeLabelPerson  = "PERSON"
+	connectedHomeLabelPet     = "PET"
+)
+
+func connectedHomeLabels_Values() []string {
+	return []string{
+		connectedHomeLabelAll,
+		connectedHomeLabelPackage,
+		connectedHomeLabelPerson,
+		connectedHomeLabelPet,
+	}
+}
@@ -0,0 +1,723 @@
+package rekognition
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceProjectVersion() *schema.Resource {
+	assetSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ground_truth_manifest": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"s3_object": {
+								Type:     schema.TypeList,
+								Required: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"bucket": {
+											Type:     schema.TypeString,
+											Required: true,
+											ForceNew: true,
+										},
+										"name": {
+											Type:     schema.TypeString,
+											Required: true,
+											ForceNew: true,
+										},
+										"version": {
+											Type:     schema.TypeString,
+											Optional: true,
+											ForceNew: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProjectVersionCreate,
+		ReadWithoutTimeout:   resourceProjectVersionRead,
+		UpdateWithoutTimeout: resourceProjectVersionUpdate,
+		DeleteWithoutTimeout: resourceProjectVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"billable_training_time_in_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"max_inference_units": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"min_inference_units": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"output_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 255),
+						},
+						"s3_key_prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+					},
+				},
+			},
+			"project_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"testing_data": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asset": assetSchema,
+						"auto_create": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"training_data": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asset": assetSchema,
+					},
+				},
+			},
+			"version_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceProjectVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	projectARN := d.Get("project_arn").(string)
+	versionName := d.Get("version_name").(string)
+	id := ProjectVersionCreateResourceID(projectARN, versionName)
+	input := &rekognition.CreateProjectVersionInput{
+		OutputConfig: expandOutputConfig(d.Get("output_config").([]interface{})),
+		ProjectArn:   aws.String(projectARN),
+		VersionName:  aws.String(versionName),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("testing_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TestingData = expandTestingData(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("training_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TrainingData = expandTrainingData(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateProjectVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Rekognition Project Version (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitProjectVersionTrainingCompleted(ctx, conn, projectARN, versionName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Rekognition Project Version (%s) training: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("min_inference_units"); ok {
+		if err := startProjectVersion(ctx, conn, projectARN, versionName, aws.StringValue(output.ProjectVersionArn), v.(int), d.Get("max_inference_units").(int), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceProjectVersionRead(ctx, d, meta)...)
+}
+
+func resourceProjectVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	projectARN, versionName, err := ProjectVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Rekognition Project Version (%s): %s", d.Id(), err)
+	}
+
+	output, err := FindProjectVersionByTwoPartKey(ctx, conn, projectARN, versionName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Rekognition Project Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Rekognition Project Version (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.ProjectVersionArn)
+	d.Set("arn", arn)
+	d.Set("billable_training_time_in_seconds", output.BillableTrainingTimeInSeconds)
+	d.Set("kms_key_id", output.KmsKeyId)
+	// Inference units are only meaningful while the model is running.
+	// A model that has been stopped out-of-band shows up as a diff and is restarted on apply.
+	switch aws.StringValue(output.Status) {
+	case rekognition.ProjectVersionStatusRunning, rekognition.ProjectVersionStatusStarting:
+		d.Set("max_inference_units", output.MaxInferenceUnits)
+		d.Set("min_inference_units", output.MinInferenceUnits)
+	default:
+		d.Set("max_inference_units", nil)
+		d.Set("min_inference_units", nil)
+	}
+	if err := d.Set("output_config", flattenOutputConfig(output.OutputConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output_config: %s", err)
+	}
+	d.Set("project_arn", projectARN)
+	d.Set("status", output.Status)
+	if output.TestingDataResult != nil && output.TestingDataResult.Input != nil {
+		if err := d.Set("testing_data", []interface{}{flattenTestingData(output.TestingDataResult.Input)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting testing_data: %s", err)
+		}
+	} else {
+		d.Set("testing_data", nil)
+	}
+	if output.TrainingDataResult != nil && output.TrainingDataResult.Input != nil {
+		if err := d.Set("training_data", []interface{}{flattenTrainingData(output.TrainingDataResult.Input)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting training_data: %s", err)
+		}
+	} else {
+		d.Set("training_data", nil)
+	}
+	d.Set("version_name", versionName)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Rekognition Project Version (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceProjectVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn()
+
+	if d.HasChanges("max_inference_units", "min_inference_units") {
+		projectARN, versionName, err := ProjectVersionParseResourceID(d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Rekognition Project Version (%s): %s", d.Id(), err)
+		}
+
+		arn := d.Get("arn").(string)
+
+		// The inference units of a running model cannot be changed in place, so stop it first.
+		if err := stopProjectVersion(ctx, conn, projectARN, versionName, arn, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if v, ok := d.GetOk("min_inference_units"); ok {
+			if err := startProjectVersion(ctx, conn, projectARN, versionName, arn, v.(int), d.Get("max_inference_units").(int), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Rekognition Project Version (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceProjectVersionRead(ctx, d, meta)...)
+}
+
+func resourceProjectVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn()
+
+	projectARN, versionName, err := ProjectVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Rekognition Project Version (%s): %s", d.Id(), err)
+	}
+
+	arn := d.Get("arn").(string)
+
+	// A running model must be stopped before it can be deleted.
+	if err := stopProjectVersion(ctx, conn, projectARN, versionName, arn, d.Timeout(schema.TimeoutDelete)); err != nil {
+		if tfresource.NotFound(err) {
+			return diags
+		}
+
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Rekognition Project Version: %s", d.Id())
+	_, err = conn.DeleteProjectVersionWithContext(ctx, &rekognition.DeleteProjectVersionInput{
+		ProjectVersionArn: aws.String(arn),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Rekognition Project Version (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitProjectVersionDeleted(ctx, conn, projectARN, versionName, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Rekognition Project Version (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// startProjectVersion starts the specified model with the given inference unit limits
+// and waits for it to be running.
+func startProjectVersion(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName, arn string, minInferenceUnits, maxInferenceUnits int, timeout time.Duration) error {
+	id := ProjectVersionCreateResourceID(projectARN, versionName)
+	input := &rekognition.StartProjectVersionInput{
+		MinInferenceUnits: aws.Int64(int64(minInferenceUnits)),
+		ProjectVersionArn: aws.String(arn),
+	}
+
+	if maxInferenceUnits > 0 {
+		input.MaxInferenceUnits = aws.Int64(int64(maxInferenceUnits))
+	}
+
+	if _, err := conn.StartProjectVersionWithContext(ctx, input); err != nil {
+		return fmt.Errorf("starting Rekognition Project Version (%s): %w", id, err)
+	}
+
+	if _, err := waitProjectVersionRunning(ctx, conn, projectARN, versionName, timeout); err != nil {
+		return fmt.Errorf("waiting for Rekognition Project Version (%s) start: %w", id, err)
+	}
+
+	return nil
+}
+
+// stopProjectVersion stops the specified model if it is starting or running
+// and waits for it to be stopped.
+func stopProjectVersion(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName, arn string, timeout time.Duration) error {
+	id := ProjectVersionCreateResourceID(projectARN, versionName)
+	output, err := FindProjectVersionByTwoPartKey(ctx, conn, projectARN, versionName)
+
+	if err != nil {
+		return fmt.Errorf("reading Rekognition Project Version (%s): %w", id, err)
+	}
+
+	switch aws.StringValue(output.Status) {
+	case rekognition.ProjectVersionStatusStarting:
+		if _, err := waitProjectVersionRunning(ctx, conn, projectARN, versionName, timeout); err != nil {
+			return fmt.Errorf("waiting for Rekognition Project Version (%s) start: %w", id, err)
+		}
+	case rekognition.ProjectVersionStatusRunning:
+	case rekognition.ProjectVersionStatusStopping:
+		if _, err := waitProjectVersionStopped(ctx, conn, projectARN, versionName, timeout); err != nil {
+			return fmt.Errorf("waiting for Rekognition Project Version (%s) stop: %w", id, err)
+		}
+
+		return nil
+	default:
+		return nil
+	}
+
+	_, err = conn.StopProjectVersionWithContext(ctx, &rekognition.StopProjectVersionInput{
+		ProjectVersionArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("stopping Rekognition Project Version (%s): %w", id, err)
+	}
+
+	if _, err := waitProjectVersionStopped(ctx, conn, projectARN, versionName, timeout); err != nil {
+		return fmt.Errorf("waiting for Rekognition Project Version (%s) stop: %w", id, err)
+	}
+
+	return nil
+}
+
+const projectVersionResourceIDSeparator = ","
+
+func ProjectVersionCreateResourceID(projectARN, versionName string) string {
+	parts := []string{projectARN, versionName}
+	id := strings.Join(parts, projectVersionResourceIDSeparator)
+
+	return id
+}
+
+func ProjectVersionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, projectVersionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected project-arn%[2]sversion-name", id, projectVersionResourceIDSeparator)
+}
+
+func FindProjectVersionByTwoPartKey(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName string) (*rekognition.ProjectVersionDescription, error) {
+	input := &rekognition.DescribeProjectVersionsInput{
+		ProjectArn:   aws.String(projectARN),
+		VersionNames: aws.StringSlice([]string{versionName}),
+	}
+
+	output, err := conn.DescribeProjectVersionsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ProjectVersionDescriptions) == 0 || output.ProjectVersionDescriptions[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ProjectVersionDescriptions); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ProjectVersionDescriptions[0], nil
+}
+
+func statusProjectVersion(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindProjectVersionByTwoPartKey(ctx, conn, projectARN, versionName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitProjectVersionTrainingCompleted(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName string, timeout time.Duration) (*rekognition.ProjectVersionDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rekognition.ProjectVersionStatusTrainingInProgress},
+		Target:  []string{rekognition.ProjectVersionStatusTrainingCompleted},
+		Refresh: statusProjectVersion(ctx, conn, projectARN, versionName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.ProjectVersionDescription); ok {
+		if status := aws.StringValue(output.Status); status == rekognition.ProjectVersionStatusTrainingFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProjectVersionRunning(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName string, timeout time.Duration) (*rekognition.ProjectVersionDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rekognition.ProjectVersionStatusStarting},
+		Target:  []string{rekognition.ProjectVersionStatusRunning},
+		Refresh: statusProjectVersion(ctx, conn, projectARN, versionName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.ProjectVersionDescription); ok {
+		if status := aws.StringValue(output.Status); status == rekognition.ProjectVersionStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProjectVersionStopped(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName string, timeout time.Duration) (*rekognition.ProjectVersionDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rekognition.ProjectVersionStatusRunning, rekognition.ProjectVersionStatusStopping},
+		Target:  []string{rekognition.ProjectVersionStatusStopped},
+		Refresh: statusProjectVersion(ctx, conn, projectARN, versionName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.ProjectVersionDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProjectVersionDeleted(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName string, timeout time.Duration) (*rekognition.ProjectVersionDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rekognition.ProjectVersionStatusDeleting},
+		Target:  []string{},
+		Refresh: statusProjectVersion(ctx, conn, projectARN, versionName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.ProjectVersionDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandOutputConfig(tfList []interface{}) *rekognition.OutputConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &rekognition.OutputConfig{
+		S3Bucket: aws.String(tfMap["s3_bucket"].(string)),
+	}
+
+	if v, ok := tfMap["s3_key_prefix"].(string); ok && v != "" {
+		apiObject.S3KeyPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTestingData(tfMap map[string]interface{}) *rekognition.TestingData {
+	apiObject := &rekognition.TestingData{
+		Assets: expandAssets(tfMap["asset"].([]interface{})),
+	}
+
+	if v, ok := tfMap["auto_create"].(bool); ok && v {
+		apiObject.AutoCreate = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandTrainingData(tfMap map[string]interface{}) *rekognition.TrainingData {
+	return &rekognition.TrainingData{
+		Assets: expandAssets(tfMap["asset"].([]interface{})),
+	}
+}
+
+func expandAssets(tfList []interface{}) []*rekognition.Asset {
+	var apiObjects []*rekognition.Asset
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &rekognition.Asset{}
+
+		if v, ok := tfMap["ground_truth_manifest"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.GroundTruthManifest = &rekognition.GroundTruthManifest{
+				S3Object: expandS3Object(v[0].(map[string]interface{})["s3_object"].([]interface{})),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandS3Object(tfList []interface{}) *rekognition.S3Object {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &rekognition.S3Object{
+		Bucket: aws.String(tfMap["bucket"].(string)),
+		Name:   aws.String(tfMap["name"].(string)),
+	}
+
+	if v, ok := tfMap["version"].(string); ok && v != "" {
+		apiObject.Version = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenOutputConfig(apiObject *rekognition.OutputConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"s3_bucket":     aws.StringValue(apiObject.S3Bucket),
+		"s3_key_prefix": aws.StringValue(apiObject.S3KeyPrefix),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTestingData(apiObject *rekognition.TestingData) map[string]interface{} {
+	return map[string]interface{}{
+		"asset":       flattenAssets(apiObject.Assets),
+		"auto_create": aws.BoolValue(apiObject.AutoCreate),
+	}
+}
+
+func flattenTrainingData(apiObject *rekognition.TrainingData) map[string]interface{} {
+	return map[string]interface{}{
+		"asset": flattenAssets(apiObject.Assets),
+	}
+}
+
+func flattenAssets(apiObjects []*rekognition.Asset) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.GroundTruthManifest == nil || apiObject.GroundTruthManifest.S3Object == nil {
+			continue
+		}
+
+		s3Object := apiObject.GroundTruthManifest.S3Object
+		tfList = append(tfList, map[string]interface{}{
+			"ground_truth_manifest": []interface{}{map[string]interface{}{
+				"s3_object": []interface{}{map[string]interface{}{
+					"bucket":  aws.StringValue(s3Object.Bucket),
+					"name":    aws.StringValue(s3Object.Name),
+					"version": aws.StringValue(s3Object.Version),
+				}},
+			}},
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,276 @@
+package rekognition_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrekognition "github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Training a model requires a labeled dataset, so the manifest must be supplied out-of-band.
+func testAccPreCheckProjectVersion(t *testing.T) (string, string) {
+	bucket := os.Getenv("REKOGNITION_TRAINING_MANIFEST_BUCKET")
+	key := os.Getenv("REKOGNITION_TRAINING_MANIFEST_KEY")
+
+	if bucket == "" || key == "" {
+		t.Skip("Environment variables REKOGNITION_TRAINING_MANIFEST_BUCKET and REKOGNITION_TRAINING_MANIFEST_KEY are not set")
+	}
+
+	return bucket, key
+}
+
+func TestAccRekognitionProjectVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	manifestBucket, manifestKey := testAccPreCheckProjectVersion(t)
+	resourceName := "aws_rekognition_project_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(rekognition.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, rekognition.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionConfig_basic(rName, manifestBucket, manifestKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "output_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "project_arn", "aws_rekognition_project.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", rekognition.ProjectVersionStatusTrainingCompleted),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "testing_data.0.auto_create", "true"),
+					resource.TestCheckResourceAttr(resourceName, "training_data.0.asset.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRekognitionProjectVersion_inferenceUnits(t *testing.T) {
+	ctx := acctest.Context(t)
+	manifestBucket, manifestKey := testAccPreCheckProjectVersion(t)
+	resourceName := "aws_rekognition_project_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(rekognition.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, rekognition.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionConfig_inferenceUnits(rName, manifestBucket, manifestKey, 1, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_inference_units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "min_inference_units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", rekognition.ProjectVersionStatusRunning),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProjectVersionConfig_inferenceUnits(rName, manifestBucket, manifestKey, 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_inference_units", "2"),
+					resource.TestCheckResourceAttr(resourceName, "min_inference_units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", rekognition.ProjectVersionStatusRunning),
+				),
+			},
+			{
+				Config: testAccProjectVersionConfig_basic(rName, manifestBucket, manifestKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_inference_units", "0"),
+					resource.TestCheckResourceAttr(resourceName, "min_inference_units", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", rekognition.ProjectVersionStatusStopped),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRekognitionProjectVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	manifestBucket, manifestKey := testAccPreCheckProjectVersion(t)
+	resourceName := "aws_rekognition_project_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(rekognition.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, rekognition.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionConfig_basic(rName, manifestBucket, manifestKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectVersionExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfrekognition.ResourceProjectVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProjectVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rekognition_project_version" {
+				continue
+			}
+
+			projectARN, versionName, err := tfrekognition.ProjectVersionParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfrekognition.FindProjectVersionByTwoPartKey(ctx, conn, projectARN, versionName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Rekognition Project Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProjectVersionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Rekognition Project Version ID is set")
+		}
+
+		projectARN, versionName, err := tfrekognition.ProjectVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionConn()
+
+		_, err = tfrekognition.FindProjectVersionByTwoPartKey(ctx, conn, projectARN, versionName)
+
+		return err
+	}
+}
+
+func testAccProjectVersionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rekognition_project" "test" {
+  name = %[1]q
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccProjectVersionConfig_basic(rName, manifestBucket, manifestKey string) string {
+	return acctest.ConfigCompose(testAccProjectVersionConfig_base(rName), fmt.Sprintf(`
+resource "aws_rekognition_project_version" "test" {
+  project_arn  = aws_rekognition_project.test.arn
+  version_name = %[1]q
+
+  output_config {
+    s3_bucket     = aws_s3_bucket.test.bucket
+    s3_key_prefix = "output"
+  }
+
+  training_data {
+    asset {
+      ground_truth_manifest {
+        s3_object {
+          bucket = %[2]q
+          name   = %[3]q
+        }
+      }
+    }
+  }
+
+  testing_data {
+    auto_create = true
+  }
+}
+`, rName, manifestBucket, manifestKey))
+}
+
+func testAccProjectVersionConfig_inferenceUnits(rName, manifestBucket, manifestKey string, minInferenceUnits, maxInferenceUnits int) string {
+	return acctest.ConfigCompose(testAccProjectVersionConfig_base(rName), fmt.Sprintf(`
+resource "aws_rekognition_project_version" "test" {
+  project_arn  = aws_rekognition_project.test.arn
+  version_name = %[1]q
+
+  min_inference_units = %[4]d
+  max_inference_units = %[5]d
+
+  output_config {
+    s3_bucket     = aws_s3_bucket.test.bucket
+    s3_key_prefix = "output"
+  }
+
+  training_data {
+    asset {
+      ground_truth_manifest {
+        s3_object {
+          bucket = %[2]q
+          name   = %[3]q
+        }
+      }
+    }
+  }
+
+  testing_data {
+    auto_create = true
+  }
+}
+`, rName, manifestBucket, manifestKey, minInferenceUnits, maxInferenceUnits))
+}
@@ -0,0 +1,317 @@
+package rekognition_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrekognition "github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRekognitionStreamProcessor_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_rekognition_stream_processor.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(rekognition.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, rekognition.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStreamProcessorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamProcessorConfig_connectedHome(rName, `["PERSON"]`, 80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamProcessorExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rekognition", regexp.MustCompile(`streamprocessor/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "input.0.kinesis_video_stream.0.arn", "aws_kinesis_video_stream.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "notification_channel.0.sns_topic_arn", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "output.0.s3_destination.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "regions_of_interest.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.connected_home.0.labels.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "settings.0.connected_home.0.labels.*", "PERSON"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.connected_home.0.min_confidence", "80"),
+					resource.TestCheckResourceAttr(resourceName, "status", rekognition.StreamProcessorStatusStopped),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRekognitionStreamProcessor_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_rekognition_stream_processor.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(rekognition.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, rekognition.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStreamProcessorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamProcessorConfig_connectedHome(rName, `["PERSON"]`, 80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamProcessorExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfrekognition.ResourceStreamProcessor(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRekognitionStreamProcessor_connectedHomeUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_rekognition_stream_processor.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(rekognition.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, rekognition.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStreamProcessorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamProcessorConfig_connectedHome(rName, `["PERSON"]`, 80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamProcessorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.connected_home.0.labels.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.connected_home.0.min_confidence", "80"),
+				),
+			},
+			{
+				Config: testAccStreamProcessorConfig_connectedHome(rName, `["PERSON", "PET"]`, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamProcessorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.connected_home.0.labels.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "settings.0.connected_home.0.labels.*", "PERSON"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "settings.0.connected_home.0.labels.*", "PET"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.connected_home.0.min_confidence", "60"),
+				),
+			},
+			{
+				Config: testAccStreamProcessorConfig_regionsOfInterest(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamProcessorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "regions_of_interest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "regions_of_interest.0.bounding_box.0.height", "0.5"),
+					resource.TestCheckResourceAttr(resourceName, "regions_of_interest.0.bounding_box.0.width", "0.5"),
+				),
+			},
+			{
+				Config: testAccStreamProcessorConfig_connectedHome(rName, `["PERSON", "PET"]`, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamProcessorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "regions_of_interest.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStreamProcessorDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rekognition_stream_processor" {
+				continue
+			}
+
+			_, err := tfrekognition.FindStreamProcessorByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Rekognition Stream Processor %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckStreamProcessorExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Rekognition Stream Processor ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionConn()
+
+		_, err := tfrekognition.FindStreamProcessorByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccStreamProcessorConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_kinesis_video_stream" "test" {
+  name                    = %[1]q
+  data_retention_in_hours = 1
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "rekognition.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["kinesisvideo:GetDataEndpoint", "kinesisvideo:GetMedia"]
+      Effect   = "Allow"
+      Resource = aws_kinesis_video_stream.test.arn
+      }, {
+      Action   = "s3:PutObject"
+      Effect   = "Allow"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+      }, {
+      Action   = "sns:Publish"
+      Effect   = "Allow"
+      Resource = aws_sns_topic.test.arn
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccStreamProcessorConfig_connectedHome(rName, labels string, minConfidence int) string {
+	return acctest.ConfigCompose(testAccStreamProcessorConfig_base(rName), fmt.Sprintf(`
+resource "aws_rekognition_stream_processor" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  input {
+    kinesis_video_stream {
+      arn = aws_kinesis_video_stream.test.arn
+    }
+  }
+
+  notification_channel {
+    sns_topic_arn = aws_sns_topic.test.arn
+  }
+
+  output {
+    s3_destination {
+      bucket     = aws_s3_bucket.test.bucket
+      key_prefix = "output"
+    }
+  }
+
+  settings {
+    connected_home {
+      labels         = %[2]s
+      min_confidence = %[3]d
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, labels, minConfidence))
+}
+
+func testAccStreamProcessorConfig_regionsOfInterest(rName string) string {
+	return acctest.ConfigCompose(testAccStreamProcessorConfig_base(rName), fmt.Sprintf(`
+resource "aws_rekognition_stream_processor" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  input {
+    kinesis_video_stream {
+      arn = aws_kinesis_video_stream.test.arn
+    }
+  }
+
+  notification_channel {
+    sns_topic_arn = aws_sns_topic.test.arn
+  }
+
+  output {
+    s3_destination {
+      bucket     = aws_s3_bucket.test.bucket
+      key_prefix = "output"
+    }
+  }
+
+  regions_of_interest {
+    bounding_box {
+      height = 0.5
+      left   = 0.25
+      top    = 0.25
+      width  = 0.5
+    }
+  }
+
+  settings {
+    connected_home {
+      labels         = ["PERSON", "PET"]
+      min_confidence = 60
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
@@ -0,0 +1,221 @@
+//go:build sweep
+// +build sweep
+
+package rekognition
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_rekognition_project", &resource.Sweeper{
+		Name: "aws_rekognition_project",
+		F:    sweepProjects,
+		Dependencies: []string{
+			"aws_rekognition_project_version",
+		},
+	})
+
+	resource.AddTestSweepers("aws_rekognition_project_version", &resource.Sweeper{
+		Name: "aws_rekognition_project_version",
+		F:    sweepProjectVersions,
+	})
+
+	resource.AddTestSweepers("aws_rekognition_stream_processor", &resource.Sweeper{
+		Name: "aws_rekognition_stream_processor",
+		F:    sweepStreamProcessors,
+	})
+}
+
+func sweepProjects(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).RekognitionConn()
+	input := &rekognition.DescribeProjectsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.DescribeProjectsPagesWithContext(ctx, input, func(page *rekognition.DescribeProjectsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ProjectDescriptions {
+			projectARN := aws.StringValue(v.ProjectArn)
+			r := ResourceProject()
+			d := r.Data(nil)
+			d.SetId(projectNameFromARN(projectARN))
+			d.Set("arn", projectARN)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Rekognition Project sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Rekognition Projects (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Rekognition Projects (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepProjectVersions(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).RekognitionConn()
+	input := &rekognition.DescribeProjectsInput{}
+	var errs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.DescribeProjectsPagesWithContext(ctx, input, func(page *rekognition.DescribeProjectsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ProjectDescriptions {
+			projectARN := aws.StringValue(v.ProjectArn)
+			input := &rekognition.DescribeProjectVersionsInput{
+				ProjectArn: aws.String(projectARN),
+			}
+
+			err := conn.DescribeProjectVersionsPagesWithContext(ctx, input, func(page *rekognition.DescribeProjectVersionsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.ProjectVersionDescriptions {
+					projectVersionARN := aws.StringValue(v.ProjectVersionArn)
+					r := ResourceProjectVersion()
+					d := r.Data(nil)
+					d.SetId(ProjectVersionCreateResourceID(projectARN, projectVersionNameFromARN(projectVersionARN)))
+					d.Set("arn", projectVersionARN)
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing Rekognition Project Versions (%s): %w", projectARN, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Rekognition Project Version sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Rekognition Projects (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Rekognition Project Versions (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepStreamProcessors(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).RekognitionConn()
+	input := &rekognition.ListStreamProcessorsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListStreamProcessorsPagesWithContext(ctx, input, func(page *rekognition.ListStreamProcessorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.StreamProcessors {
+			r := ResourceStreamProcessor()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+			d.Set("status", v.Status)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Rekognition Stream Processor sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Rekognition Stream Processors (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Rekognition Stream Processors (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+// projectNameFromARN returns the project name from an ARN of the form
+// arn:${Partition}:rekognition:${Region}:${Account}:project/${ProjectName}/${CreationTimestamp}.
+func projectNameFromARN(s string) string {
+	return arnResourcePart(s, 1)
+}
+
+// projectVersionNameFromARN returns the version name from an ARN of the form
+// arn:${Partition}:rekognition:${Region}:${Account}:project/${ProjectName}/version/${VersionName}/${CreationTimestamp}.
+func projectVersionNameFromARN(s string) string {
+	return arnResourcePart(s, 3)
+}
+
+func arnResourcePart(s string, i int) string {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return ""
+	}
+
+	parts := strings.Split(v.Resource, "/")
+
+	if i >= len(parts) {
+		return ""
+	}
+
+	return parts[i]
+}
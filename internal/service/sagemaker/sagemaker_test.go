@@ -83,18 +83,20 @@ func TestAccSageMaker_serial(t *testing.T) {
 			"jupyterServerAppSettings":                 testAccUserProfile_jupyterServerAppSettings,
 		},
 		"Workforce": {
-			"disappears":     testAccWorkforce_disappears,
-			"CognitoConfig":  testAccWorkforce_cognitoConfig,
-			"OidcConfig":     testAccWorkforce_oidcConfig,
-			"SourceIpConfig": testAccWorkforce_sourceIPConfig,
-			"VPC":            testAccWorkforce_vpc,
+			"disappears":        testAccWorkforce_disappears,
+			"CognitoConfig":     testAccWorkforce_cognitoConfig,
+			"OidcConfig":        testAccWorkforce_oidcConfig,
+			"SourceIpConfig":    testAccWorkforce_sourceIPConfig,
+			"VPC":               testAccWorkforce_vpc,
+			"VPCSourceIpConfig": testAccWorkforce_vpcSourceIPConfig,
 		},
 		"Workteam": {
-			"disappears":         testAccWorkteam_disappears,
-			"CognitoConfig":      testAccWorkteam_cognitoConfig,
-			"NotificationConfig": testAccWorkteam_notificationConfig,
-			"OidcConfig":         testAccWorkteam_oidcConfig,
-			"Tags":               testAccWorkteam_tags,
+			"disappears":                 testAccWorkteam_disappears,
+			"CognitoConfig":              testAccWorkteam_cognitoConfig,
+			"MemberDefinitionValidation": testAccWorkteam_memberDefinitionValidation,
+			"NotificationConfig":         testAccWorkteam_notificationConfig,
+			"OidcConfig":                 testAccWorkteam_oidcConfig,
+			"Tags":                       testAccWorkteam_tags,
 		},
 		"Servicecatalog": {
 			"basic": testAccServicecatalogPortfolioStatus_basic,
@@ -2,6 +2,7 @@ package sagemaker
 
 import (
 	"context"
+	"errors"
 	"log"
 	"regexp"
 
@@ -27,6 +28,8 @@ func ResourceWorkforce() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceWorkforceCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -319,6 +322,33 @@ func resourceWorkforceDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+// SageMaker does not support source IP restrictions for worker portals in a VPC.
+// Reject CIDRs configured alongside a VPC configuration, and clear any CIDRs left
+// over in state when moving a workforce into a VPC, so that both changes are
+// sent in the same UpdateWorkforce call.
+func resourceWorkforceCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if len(diff.Get("workforce_vpc_config").([]interface{})) == 0 {
+		return nil
+	}
+
+	cidrs := 0
+	if v, ok := diff.Get("source_ip_config.0.cidrs").(*schema.Set); ok {
+		cidrs = v.Len()
+	}
+
+	if cidrs == 0 {
+		return nil
+	}
+
+	if v := diff.GetRawConfig().GetAttr("source_ip_config"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		return errors.New("source_ip_config.cidrs must be empty when workforce_vpc_config is set")
+	}
+
+	return diff.SetNew("source_ip_config", []interface{}{map[string]interface{}{
+		"cidrs": []interface{}{},
+	}})
+}
+
 func expandWorkforceSourceIPConfig(l []interface{}) *sagemaker.SourceIpConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -204,6 +204,43 @@ func testAccWorkforce_vpc(t *testing.T) {
 	})
 }
 
+func testAccWorkforce_vpcSourceIPConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var workforce sagemaker.Workforce
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_workforce.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkforceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWorkforceConfig_vpcSourceIP(rName, "1.1.1.1/32"),
+				ExpectError: regexp.MustCompile(`source_ip_config.cidrs must be empty when workforce_vpc_config is set`),
+			},
+			{
+				Config: testAccWorkforceConfig_sourceIP1(rName, "1.1.1.1/32"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkforceExists(ctx, resourceName, &workforce),
+					resource.TestCheckResourceAttr(resourceName, "source_ip_config.0.cidrs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "workforce_vpc_config.#", "0"),
+				),
+			},
+			{
+				Config: testAccWorkforceConfig_vpc(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkforceExists(ctx, resourceName, &workforce),
+					resource.TestCheckResourceAttr(resourceName, "source_ip_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_ip_config.0.cidrs.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "workforce_vpc_config.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccWorkforce_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var workforce sagemaker.Workforce
@@ -417,6 +454,29 @@ resource "aws_sagemaker_workforce" "test" {
 `, rName))
 }
 
+func testAccWorkforceConfig_vpcSourceIP(rName, cidr1 string) string {
+	return acctest.ConfigCompose(testAccWorkforceConfig_vpcBase(rName), fmt.Sprintf(`
+resource "aws_sagemaker_workforce" "test" {
+  workforce_name = %[1]q
+
+  cognito_config {
+    client_id = aws_cognito_user_pool_client.test.id
+    user_pool = aws_cognito_user_pool_domain.test.user_pool_id
+  }
+
+  source_ip_config {
+    cidrs = [%[2]q]
+  }
+
+  workforce_vpc_config {
+    security_group_ids = [aws_security_group.test.id]
+    subnets            = [aws_subnet.test.id]
+    vpc_id             = aws_vpc.test.id
+  }
+}
+`, rName, cidr1))
+}
+
 func testAccWorkforceConfig_vpcRemove(rName string) string {
 	return acctest.ConfigCompose(testAccWorkforceConfig_vpcBase(rName), fmt.Sprintf(`
 resource "aws_sagemaker_workforce" "test" {
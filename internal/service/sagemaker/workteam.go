@@ -2,6 +2,8 @@ package sagemaker
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"regexp"
 	"time"
@@ -10,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -127,7 +130,10 @@ func ResourceWorkteam() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceWorkteamCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -225,8 +231,11 @@ func resourceWorkteamUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		input := &sagemaker.UpdateWorkteamInput{
-			WorkteamName:      aws.String(d.Id()),
-			MemberDefinitions: expandWorkteamMemberDefinition(d.Get("member_definition").([]interface{})),
+			WorkteamName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("member_definition") {
+			input.MemberDefinitions = expandWorkteamMemberDefinition(d.Get("member_definition").([]interface{}))
 		}
 
 		if d.HasChange("description") {
@@ -276,6 +285,41 @@ func resourceWorkteamDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return diags
 }
 
+// A work team is made up of either Amazon Cognito user groups or a single set of
+// OIDC IdP groups, never both. Catch invalid member definitions at plan time
+// rather than partway through an update.
+func resourceWorkteamCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	var cognito, oidc int
+
+	for i, tfMapRaw := range diff.Get("member_definition").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		c := len(tfMap["cognito_member_definition"].([]interface{}))
+		o := len(tfMap["oidc_member_definition"].([]interface{}))
+
+		if c+o != 1 {
+			return fmt.Errorf("member_definition.%d: exactly one of cognito_member_definition or oidc_member_definition must be set", i)
+		}
+
+		cognito += c
+		oidc += o
+	}
+
+	if cognito > 0 && oidc > 0 {
+		return errors.New("member_definition: cognito_member_definition and oidc_member_definition cannot be combined in the same work team")
+	}
+
+	if oidc > 1 {
+		return errors.New("member_definition: only one oidc_member_definition is allowed; list all OIDC groups in its groups argument")
+	}
+
+	return nil
+}
+
 func expandWorkteamMemberDefinition(l []interface{}) []*sagemaker.MemberDefinition {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -146,6 +146,28 @@ func testAccWorkteam_oidcConfig(t *testing.T) {
 	})
 }
 
+func testAccWorkteam_memberDefinitionValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkteamDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWorkteamConfig_oidcMixed(rName),
+				ExpectError: regexp.MustCompile(`cannot be combined in the same work team`),
+			},
+			{
+				Config:      testAccWorkteamConfig_oidcMultiple(rName),
+				ExpectError: regexp.MustCompile(`only one oidc_member_definition is allowed`),
+			},
+		},
+	})
+}
+
 func testAccWorkteam_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var workteam sagemaker.Workteam
@@ -455,6 +477,52 @@ resource "aws_sagemaker_workteam" "test" {
 `, rName, group))
 }
 
+func testAccWorkteamConfig_oidcMixed(rName string) string {
+	return acctest.ConfigCompose(testAccWorkteamOIDCBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sagemaker_workteam" "test" {
+  workteam_name  = %[1]q
+  workforce_name = aws_sagemaker_workforce.test.id
+  description    = %[1]q
+
+  member_definition {
+    oidc_member_definition {
+      groups = [%[1]q]
+    }
+  }
+
+  member_definition {
+    cognito_member_definition {
+      client_id  = %[1]q
+      user_pool  = %[1]q
+      user_group = %[1]q
+    }
+  }
+}
+`, rName))
+}
+
+func testAccWorkteamConfig_oidcMultiple(rName string) string {
+	return acctest.ConfigCompose(testAccWorkteamOIDCBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sagemaker_workteam" "test" {
+  workteam_name  = %[1]q
+  workforce_name = aws_sagemaker_workforce.test.id
+  description    = %[1]q
+
+  member_definition {
+    oidc_member_definition {
+      groups = [%[1]q]
+    }
+  }
+
+  member_definition {
+    oidc_member_definition {
+      groups = ["test"]
+    }
+  }
+}
+`, rName))
+}
+
 func testAccWorkteamConfig_notification(rName string) string {
 	return acctest.ConfigCompose(testAccWorkteamOIDCBaseConfig(rName), fmt.Sprintf(`
 resource "aws_sns_topic" "test" {
@@ -0,0 +1,181 @@
+package snowball
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceAddress() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAddressCreate,
+		ReadWithoutTimeout:   resourceAddressRead,
+		DeleteWithoutTimeout: resourceAddressDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"city": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"company": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"country": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"is_restricted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"landmark": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"phone_number": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"postal_code": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"prefecture_or_district": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"state_or_province": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"street_1": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"street_2": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"street_3": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAddressCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballConn()
+
+	address := &snowball.Address{
+		City:            aws.String(d.Get("city").(string)),
+		Country:         aws.String(d.Get("country").(string)),
+		Name:            aws.String(d.Get("name").(string)),
+		PhoneNumber:     aws.String(d.Get("phone_number").(string)),
+		PostalCode:      aws.String(d.Get("postal_code").(string)),
+		StateOrProvince: aws.String(d.Get("state_or_province").(string)),
+		Street1:         aws.String(d.Get("street_1").(string)),
+	}
+
+	if v, ok := d.GetOk("company"); ok {
+		address.Company = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("landmark"); ok {
+		address.Landmark = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("prefecture_or_district"); ok {
+		address.PrefectureOrDistrict = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("street_2"); ok {
+		address.Street2 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("street_3"); ok {
+		address.Street3 = aws.String(v.(string))
+	}
+
+	input := &snowball.CreateAddressInput{
+		Address: address,
+	}
+
+	output, err := conn.CreateAddressWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Snowball Address: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.AddressId))
+
+	return append(diags, resourceAddressRead(ctx, d, meta)...)
+}
+
+func resourceAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballConn()
+
+	address, err := FindAddressByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Snowball Address (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Snowball Address (%s): %s", d.Id(), err)
+	}
+
+	d.Set("city", address.City)
+	d.Set("company", address.Company)
+	d.Set("country", address.Country)
+	d.Set("is_restricted", address.IsRestricted)
+	d.Set("landmark", address.Landmark)
+	d.Set("name", address.Name)
+	d.Set("phone_number", address.PhoneNumber)
+	d.Set("postal_code", address.PostalCode)
+	d.Set("prefecture_or_district", address.PrefectureOrDistrict)
+	d.Set("state_or_province", address.StateOrProvince)
+	d.Set("street_1", address.Street1)
+	d.Set("street_2", address.Street2)
+	d.Set("street_3", address.Street3)
+
+	return diags
+}
+
+func resourceAddressDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Snowball Address (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package snowball
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourceAddress() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAddressRead,
+
+		Schema: map[string]*schema.Schema{
+			"address_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"city": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"company": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"country": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_restricted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"landmark": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"phone_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"postal_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"prefecture_or_district": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_or_province": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"street_1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"street_2": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"street_3": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballConn()
+
+	addressID := d.Get("address_id").(string)
+	address, err := FindAddressByID(ctx, conn, addressID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Snowball Address (%s): %s", addressID, err)
+	}
+
+	d.SetId(addressID)
+	d.Set("city", address.City)
+	d.Set("company", address.Company)
+	d.Set("country", address.Country)
+	d.Set("is_restricted", address.IsRestricted)
+	d.Set("landmark", address.Landmark)
+	d.Set("name", address.Name)
+	d.Set("phone_number", address.PhoneNumber)
+	d.Set("postal_code", address.PostalCode)
+	d.Set("prefecture_or_district", address.PrefectureOrDistrict)
+	d.Set("state_or_province", address.StateOrProvince)
+	d.Set("street_1", address.Street1)
+	d.Set("street_2", address.Street2)
+	d.Set("street_3", address.Street3)
+
+	return diags
+}
@@ -0,0 +1,49 @@
+package snowball_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/snowball"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSnowballAddressDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_snowball_address.test"
+	resourceName := "aws_snowball_address.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(snowball.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, snowball.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddressDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddressExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "city", resourceName, "city"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "country", resourceName, "country"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "phone_number", resourceName, "phone_number"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "postal_code", resourceName, "postal_code"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "state_or_province", resourceName, "state_or_province"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "street_1", resourceName, "street_1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAddressDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAddressConfig_basic(rName), `
+data "aws_snowball_address" "test" {
+  address_id = aws_snowball_address.test.id
+}
+`)
+}
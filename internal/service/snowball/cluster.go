@@ -0,0 +1,264 @@
+package snowball
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceClusterCreate,
+		ReadWithoutTimeout:   resourceClusterRead,
+		UpdateWithoutTimeout: resourceClusterUpdate,
+		DeleteWithoutTimeout: resourceClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cluster_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"forwarding_address_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"job_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.JobType_Values(), false),
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"notification": notificationSchema(),
+			"remote_management": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.RemoteManagement_Values(), false),
+			},
+			"resources": jobResourcesSchema(),
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"shipping_option": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(snowball.ShippingOption_Values(), false),
+			},
+			"snowball_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.Type_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballConn()
+
+	input := &snowball.CreateClusterInput{
+		AddressId:      aws.String(d.Get("address_id").(string)),
+		JobType:        aws.String(d.Get("job_type").(string)),
+		Resources:      &snowball.JobResource{},
+		RoleARN:        aws.String(d.Get("role_arn").(string)),
+		ShippingOption: aws.String(d.Get("shipping_option").(string)),
+		SnowballType:   aws.String(d.Get("snowball_type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("forwarding_address_id"); ok {
+		input.ForwardingAddressId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Notification = expandNotification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("remote_management"); ok {
+		input.RemoteManagement = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resources"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resources = expandJobResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Snowball Cluster: %s", input)
+	output, err := conn.CreateClusterWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Snowball Cluster: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ClusterId))
+
+	return append(diags, resourceClusterRead(ctx, d, meta)...)
+}
+
+func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballConn()
+
+	cluster, err := FindClusterByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Snowball Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Snowball Cluster (%s): %s", d.Id(), err)
+	}
+
+	d.Set("address_id", cluster.AddressId)
+	d.Set("cluster_state", cluster.ClusterState)
+	if v := cluster.CreationDate; v != nil {
+		d.Set("creation_date", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+	d.Set("description", cluster.Description)
+	d.Set("forwarding_address_id", cluster.ForwardingAddressId)
+	d.Set("job_type", cluster.JobType)
+	d.Set("kms_key_arn", cluster.KmsKeyARN)
+	if cluster.Notification != nil {
+		if err := d.Set("notification", []interface{}{flattenNotification(cluster.Notification)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting notification: %s", err)
+		}
+	} else {
+		d.Set("notification", nil)
+	}
+	if cluster.Resources != nil {
+		if err := d.Set("resources", []interface{}{flattenJobResource(cluster.Resources)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting resources: %s", err)
+		}
+	} else {
+		d.Set("resources", nil)
+	}
+	d.Set("role_arn", cluster.RoleARN)
+	d.Set("shipping_option", cluster.ShippingOption)
+	d.Set("snowball_type", cluster.SnowballType)
+
+	return diags
+}
+
+func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballConn()
+
+	input := &snowball.UpdateClusterInput{
+		ClusterId: aws.String(d.Id()),
+	}
+
+	if d.HasChange("address_id") {
+		input.AddressId = aws.String(d.Get("address_id").(string))
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("forwarding_address_id") {
+		input.ForwardingAddressId = aws.String(d.Get("forwarding_address_id").(string))
+	}
+
+	if d.HasChange("notification") {
+		if v, ok := d.GetOk("notification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Notification = expandNotification(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	if d.HasChange("resources") {
+		if v, ok := d.GetOk("resources"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Resources = expandJobResource(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	if d.HasChange("role_arn") {
+		input.RoleARN = aws.String(d.Get("role_arn").(string))
+	}
+
+	if d.HasChange("shipping_option") {
+		input.ShippingOption = aws.String(d.Get("shipping_option").(string))
+	}
+
+	log.Printf("[DEBUG] Updating Snowball Cluster: %s", input)
+	_, err := conn.UpdateClusterWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Snowball Cluster (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceClusterRead(ctx, d, meta)...)
+}
+
+func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballConn()
+
+	log.Printf("[DEBUG] Cancelling Snowball Cluster: %s", d.Id())
+	_, err := conn.CancelClusterWithContext(ctx, &snowball.CancelClusterInput{
+		ClusterId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, snowball.ErrCodeInvalidResourceException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling Snowball Cluster (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitClusterDeleted(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Snowball Cluster (%s) cancel: %s", d.Id(), err)
+	}
+
+	return diags
+}
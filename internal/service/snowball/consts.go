@@ -0,0 +1,33 @@
+package snowball
+
+import (
+	"github.com/aws/aws-sdk-go/service/snowball"
+)
+
+// jobStateProgression lists job states in the order a job moves through them.
+// Jobs that belong to a cluster start in Pending, export jobs pass through Listing.
+var jobStateProgression = []string{
+	snowball.JobStatePending,
+	snowball.JobStateNew,
+	snowball.JobStateListing,
+	snowball.JobStatePreparingAppliance,
+	snowball.JobStatePreparingShipment,
+	snowball.JobStateInTransitToCustomer,
+	snowball.JobStateWithCustomer,
+	snowball.JobStateInTransitToAws,
+	snowball.JobStateWithAwssortingFacility,
+	snowball.JobStateWithAws,
+	snowball.JobStateInProgress,
+	snowball.JobStateComplete,
+}
+
+// jobStatesBefore splits jobStateProgression around the specified state.
+func jobStatesBefore(state string) ([]string, []string) {
+	for i, v := range jobStateProgression {
+		if v == state {
+			return jobStateProgression[:i], jobStateProgression[i:]
+		}
+	}
+
+	return nil, []string{state}
+}
@@ -0,0 +1,100 @@
+package snowball
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAddressByID(ctx context.Context, conn *snowball.Snowball, id string) (*snowball.Address, error) {
+	input := &snowball.DescribeAddressInput{
+		AddressId: aws.String(id),
+	}
+
+	output, err := conn.DescribeAddressWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, snowball.ErrCodeInvalidResourceException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Address == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Address, nil
+}
+
+func FindClusterByID(ctx context.Context, conn *snowball.Snowball, id string) (*snowball.ClusterMetadata, error) {
+	input := &snowball.DescribeClusterInput{
+		ClusterId: aws.String(id),
+	}
+
+	output, err := conn.DescribeClusterWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, snowball.ErrCodeInvalidResourceException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ClusterMetadata == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.ClusterMetadata.ClusterState); state == snowball.ClusterStateCancelled {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output.ClusterMetadata, nil
+}
+
+func FindJobByID(ctx context.Context, conn *snowball.Snowball, id string) (*snowball.JobMetadata, error) {
+	input := &snowball.DescribeJobInput{
+		JobId: aws.String(id),
+	}
+
+	output, err := conn.DescribeJobWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, snowball.ErrCodeInvalidResourceException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JobMetadata == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.JobMetadata.JobState); state == snowball.JobStateCancelled {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output.JobMetadata, nil
+}
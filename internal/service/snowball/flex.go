@@ -0,0 +1,253 @@
+package snowball
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func expandJobResource(tfMap map[string]interface{}) *snowball.JobResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &snowball.JobResource{}
+
+	if v, ok := tfMap["ec2_ami_resource"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Ec2AmiResources = expandEC2AMIResources(v)
+	}
+
+	if v, ok := tfMap["lambda_resource"].([]interface{}); ok && len(v) > 0 {
+		apiObject.LambdaResources = expandLambdaResources(v)
+	}
+
+	if v, ok := tfMap["s3_resource"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3Resources = expandS3Resources(v)
+	}
+
+	return apiObject
+}
+
+func expandEC2AMIResources(tfList []interface{}) []*snowball.Ec2AmiResource {
+	var apiObjects []*snowball.Ec2AmiResource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &snowball.Ec2AmiResource{
+			AmiId: aws.String(tfMap["ami_id"].(string)),
+		}
+
+		if v, ok := tfMap["snowball_ami_id"].(string); ok && v != "" {
+			apiObject.SnowballAmiId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandLambdaResources(tfList []interface{}) []*snowball.LambdaResource {
+	var apiObjects []*snowball.LambdaResource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &snowball.LambdaResource{}
+
+		if v, ok := tfMap["event_resource_arns"].([]interface{}); ok && len(v) > 0 {
+			for _, v := range flex.ExpandStringList(v) {
+				apiObject.EventTriggers = append(apiObject.EventTriggers, &snowball.EventTriggerDefinition{
+					EventResourceARN: v,
+				})
+			}
+		}
+
+		if v, ok := tfMap["lambda_arn"].(string); ok && v != "" {
+			apiObject.LambdaArn = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandS3Resources(tfList []interface{}) []*snowball.S3Resource {
+	var apiObjects []*snowball.S3Resource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &snowball.S3Resource{
+			BucketArn: aws.String(tfMap["bucket_arn"].(string)),
+		}
+
+		if v, ok := tfMap["key_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			keyRange := &snowball.KeyRange{}
+
+			if v, ok := tfMap["begin_marker"].(string); ok && v != "" {
+				keyRange.BeginMarker = aws.String(v)
+			}
+
+			if v, ok := tfMap["end_marker"].(string); ok && v != "" {
+				keyRange.EndMarker = aws.String(v)
+			}
+
+			apiObject.KeyRange = keyRange
+		}
+
+		if v, ok := tfMap["target_on_device_service"].([]interface{}); ok && len(v) > 0 {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				apiObject.TargetOnDeviceServices = append(apiObject.TargetOnDeviceServices, &snowball.TargetOnDeviceService{
+					ServiceName:    aws.String(tfMap["service_name"].(string)),
+					TransferOption: aws.String(tfMap["transfer_option"].(string)),
+				})
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenJobResource(apiObject *snowball.JobResource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Ec2AmiResources; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"ami_id":          aws.StringValue(apiObject.AmiId),
+				"snowball_ami_id": aws.StringValue(apiObject.SnowballAmiId),
+			})
+		}
+
+		tfMap["ec2_ami_resource"] = tfList
+	}
+
+	if v := apiObject.LambdaResources; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			var eventResourceARNs []string
+
+			for _, v := range apiObject.EventTriggers {
+				eventResourceARNs = append(eventResourceARNs, aws.StringValue(v.EventResourceARN))
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"event_resource_arns": eventResourceARNs,
+				"lambda_arn":          aws.StringValue(apiObject.LambdaArn),
+			})
+		}
+
+		tfMap["lambda_resource"] = tfList
+	}
+
+	if v := apiObject.S3Resources; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfMap := map[string]interface{}{
+				"bucket_arn": aws.StringValue(apiObject.BucketArn),
+			}
+
+			if v := apiObject.KeyRange; v != nil {
+				tfMap["key_range"] = []interface{}{map[string]interface{}{
+					"begin_marker": aws.StringValue(v.BeginMarker),
+					"end_marker":   aws.StringValue(v.EndMarker),
+				}}
+			}
+
+			var targets []interface{}
+
+			for _, v := range apiObject.TargetOnDeviceServices {
+				targets = append(targets, map[string]interface{}{
+					"service_name":    aws.StringValue(v.ServiceName),
+					"transfer_option": aws.StringValue(v.TransferOption),
+				})
+			}
+
+			tfMap["target_on_device_service"] = targets
+
+			tfList = append(tfList, tfMap)
+		}
+
+		tfMap["s3_resource"] = tfList
+	}
+
+	return tfMap
+}
+
+func expandNotification(tfMap map[string]interface{}) *snowball.Notification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &snowball.Notification{}
+
+	if v, ok := tfMap["job_states_to_notify"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.JobStatesToNotify = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["notify_all"].(bool); ok {
+		apiObject.NotifyAll = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["sns_topic_arn"].(string); ok && v != "" {
+		apiObject.SnsTopicARN = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenNotification(apiObject *snowball.Notification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"job_states_to_notify": aws.StringValueSlice(apiObject.JobStatesToNotify),
+		"notify_all":           aws.BoolValue(apiObject.NotifyAll),
+		"sns_topic_arn":        aws.StringValue(apiObject.SnsTopicARN),
+	}
+}
+
+func flattenShipment(apiObject *snowball.Shipment) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"status":          aws.StringValue(apiObject.Status),
+		"tracking_number": aws.StringValue(apiObject.TrackingNumber),
+	}
+}
@@ -0,0 +1,523 @@
+package snowball
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobCreate,
+		ReadWithoutTimeout:   resourceJobRead,
+		UpdateWithoutTimeout: resourceJobUpdate,
+		DeleteWithoutTimeout: resourceJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"forwarding_address_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"job_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.JobType_Values(), false),
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"long_term_pricing_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"notification": notificationSchema(),
+			"remote_management": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.RemoteManagement_Values(), false),
+			},
+			"resources": jobResourcesSchema(),
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"shipping_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"inbound_shipment":  shipmentSchema(),
+						"outbound_shipment": shipmentSchema(),
+						"shipping_option": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"shipping_option": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(snowball.ShippingOption_Values(), false),
+			},
+			"snowball_capacity_preference": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(snowball.Capacity_Values(), false),
+			},
+			"snowball_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(snowball.Type_Values(), false),
+			},
+			"wait_for_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(jobStateProgression, false),
+			},
+		},
+	}
+}
+
+func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballConn()
+
+	input := &snowball.CreateJobInput{}
+
+	if v, ok := d.GetOk("address_id"); ok {
+		input.AddressId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cluster_id"); ok {
+		input.ClusterId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("forwarding_address_id"); ok {
+		input.ForwardingAddressId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("job_type"); ok {
+		input.JobType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("long_term_pricing_id"); ok {
+		input.LongTermPricingId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Notification = expandNotification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("remote_management"); ok {
+		input.RemoteManagement = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resources"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resources = expandJobResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("shipping_option"); ok {
+		input.ShippingOption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snowball_capacity_preference"); ok {
+		input.SnowballCapacityPreference = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snowball_type"); ok {
+		input.SnowballType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Snowball Job: %s", input)
+	output, err := conn.CreateJobWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Snowball Job: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.JobId))
+
+	if v, ok := d.GetOk("wait_for_state"); ok {
+		if _, err := waitJobStateReached(ctx, conn, d.Id(), v.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Snowball Job (%s) to reach state %s: %s", d.Id(), v.(string), err)
+		}
+	}
+
+	return append(diags, resourceJobRead(ctx, d, meta)...)
+}
+
+func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballConn()
+
+	job, err := FindJobByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Snowball Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Snowball Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set("address_id", job.AddressId)
+	d.Set("cluster_id", job.ClusterId)
+	if v := job.CreationDate; v != nil {
+		d.Set("creation_date", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+	d.Set("description", job.Description)
+	d.Set("forwarding_address_id", job.ForwardingAddressId)
+	d.Set("job_state", job.JobState)
+	d.Set("job_type", job.JobType)
+	d.Set("kms_key_arn", job.KmsKeyARN)
+	d.Set("long_term_pricing_id", job.LongTermPricingId)
+	if job.Notification != nil {
+		if err := d.Set("notification", []interface{}{flattenNotification(job.Notification)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting notification: %s", err)
+		}
+	} else {
+		d.Set("notification", nil)
+	}
+	d.Set("remote_management", job.RemoteManagement)
+	if job.Resources != nil {
+		if err := d.Set("resources", []interface{}{flattenJobResource(job.Resources)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting resources: %s", err)
+		}
+	} else {
+		d.Set("resources", nil)
+	}
+	d.Set("role_arn", job.RoleARN)
+	if v := job.ShippingDetails; v != nil {
+		tfMap := map[string]interface{}{
+			"shipping_option": aws.StringValue(v.ShippingOption),
+		}
+
+		if v := v.InboundShipment; v != nil {
+			tfMap["inbound_shipment"] = []interface{}{flattenShipment(v)}
+		}
+
+		if v := v.OutboundShipment; v != nil {
+			tfMap["outbound_shipment"] = []interface{}{flattenShipment(v)}
+		}
+
+		if err := d.Set("shipping_details", []interface{}{tfMap}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting shipping_details: %s", err)
+		}
+
+		d.Set("shipping_option", v.ShippingOption)
+	} else {
+		d.Set("shipping_details", nil)
+		d.Set("shipping_option", nil)
+	}
+	d.Set("snowball_capacity_preference", job.SnowballCapacityPreference)
+	d.Set("snowball_type", job.SnowballType)
+
+	return diags
+}
+
+func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballConn()
+
+	if d.HasChangesExcept("wait_for_state") {
+		input := &snowball.UpdateJobInput{
+			JobId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("address_id") {
+			input.AddressId = aws.String(d.Get("address_id").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("forwarding_address_id") {
+			input.ForwardingAddressId = aws.String(d.Get("forwarding_address_id").(string))
+		}
+
+		if d.HasChange("notification") {
+			if v, ok := d.GetOk("notification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Notification = expandNotification(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("resources") {
+			if v, ok := d.GetOk("resources"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Resources = expandJobResource(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleARN = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("shipping_option") {
+			input.ShippingOption = aws.String(d.Get("shipping_option").(string))
+		}
+
+		if d.HasChange("snowball_capacity_preference") {
+			input.SnowballCapacityPreference = aws.String(d.Get("snowball_capacity_preference").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Snowball Job: %s", input)
+		_, err := conn.UpdateJobWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Snowball Job (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceJobRead(ctx, d, meta)...)
+}
+
+func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SnowballConn()
+
+	log.Printf("[DEBUG] Cancelling Snowball Job: %s", d.Id())
+	_, err := conn.CancelJobWithContext(ctx, &snowball.CancelJobInput{
+		JobId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, snowball.ErrCodeInvalidResourceException) {
+		return diags
+	}
+
+	// Jobs can only be cancelled before the device has been prepared for shipping.
+	if tfawserr.ErrCodeEquals(err, snowball.ErrCodeInvalidJobStateException) {
+		return sdkdiag.AppendErrorf(diags, "cancelling Snowball Job (%s): job can no longer be cancelled: %s", d.Id(), err)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling Snowball Job (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitJobDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Snowball Job (%s) cancel: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func jobResourcesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ec2_ami_resource": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"ami_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"snowball_ami_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"lambda_resource": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"event_resource_arns": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: verify.ValidARN,
+								},
+							},
+							"lambda_arn": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+				"s3_resource": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"bucket_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+							"key_range": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"begin_marker": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"end_marker": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+									},
+								},
+							},
+							"target_on_device_service": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"service_name": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringInSlice(snowball.DeviceServiceName_Values(), false),
+										},
+										"transfer_option": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringInSlice(snowball.TransferOption_Values(), false),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func notificationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"job_states_to_notify": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(snowball.JobState_Values(), false),
+					},
+				},
+				"notify_all": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"sns_topic_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func shipmentSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tracking_number": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
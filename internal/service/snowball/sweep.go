@@ -0,0 +1,128 @@
+//go:build sweep
+// +build sweep
+
+package snowball
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_snowball_cluster", &resource.Sweeper{
+		Name: "aws_snowball_cluster",
+		F:    sweepClusters,
+		Dependencies: []string{
+			"aws_snowball_job",
+		},
+	})
+
+	resource.AddTestSweepers("aws_snowball_job", &resource.Sweeper{
+		Name: "aws_snowball_job",
+		F:    sweepJobs,
+	})
+}
+
+func sweepClusters(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).SnowballConn()
+	input := &snowball.ListClustersInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListClustersPagesWithContext(ctx, input, func(page *snowball.ListClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ClusterListEntries {
+			// Only clusters that are still awaiting quorum can be cancelled.
+			if aws.StringValue(v.ClusterState) != snowball.ClusterStateAwaitingQuorum {
+				continue
+			}
+
+			r := ResourceCluster()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ClusterId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Snowball Cluster sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Snowball Clusters (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Snowball Clusters (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepJobs(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).SnowballConn()
+	input := &snowball.ListJobsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListJobsPagesWithContext(ctx, input, func(page *snowball.ListJobsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.JobListEntries {
+			// Only jobs that have not yet been prepared can be cancelled.
+			if aws.StringValue(v.JobState) != snowball.JobStateNew {
+				continue
+			}
+
+			r := ResourceJob()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.JobId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Snowball Job sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Snowball Jobs (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Snowball Jobs (%s): %w", region, err)
+	}
+
+	return nil
+}
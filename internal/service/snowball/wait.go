@@ -0,0 +1,68 @@
+package snowball
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/snowball"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	clusterDeletedTimeout = 10 * time.Minute
+)
+
+func waitClusterDeleted(ctx context.Context, conn *snowball.Snowball, id string) (*snowball.ClusterMetadata, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{snowball.ClusterStateAwaitingQuorum, snowball.ClusterStatePending},
+		Target:  []string{},
+		Refresh: statusClusterState(ctx, conn, id),
+		Timeout: clusterDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*snowball.ClusterMetadata); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitJobStateReached waits for the job to reach the specified state or any state after it.
+func waitJobStateReached(ctx context.Context, conn *snowball.Snowball, id, state string, timeout time.Duration) (*snowball.JobMetadata, error) {
+	pending, target := jobStatesBefore(state)
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     target,
+		Refresh:    statusJobState(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*snowball.JobMetadata); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitJobDeleted(ctx context.Context, conn *snowball.Snowball, id string, timeout time.Duration) (*snowball.JobMetadata, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{snowball.JobStatePending, snowball.JobStateNew},
+		Target:  []string{},
+		Refresh: statusJobState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*snowball.JobMetadata); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -28,6 +30,11 @@ func ResourceAccountAssignment() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_arn": {
 				Type:         schema.TypeString,
@@ -108,18 +115,24 @@ func resourceAccountAssignmentCreate(ctx context.Context, d *schema.ResourceData
 		TargetType:       aws.String(targetType),
 	}
 
-	output, err := conn.CreateAccountAssignmentWithContext(ctx, input)
+	// Assignments to the same permission set are processed one at a time by the service.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return conn.CreateAccountAssignmentWithContext(ctx, input)
+	}, ssoadmin.ErrCodeConflictException, ssoadmin.ErrCodeThrottlingException)
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating SSO Account Assignment for %s (%s): %s", principalType, principalID, err)
 	}
 
+	output := outputRaw.(*ssoadmin.CreateAccountAssignmentOutput)
+
 	if output == nil || output.AccountAssignmentCreationStatus == nil {
 		return sdkdiag.AppendErrorf(diags, "creating SSO Account Assignment for %s (%s): empty output", principalType, principalID)
 	}
 
 	status := output.AccountAssignmentCreationStatus
 
-	_, err = waitAccountAssignmentCreated(ctx, conn, instanceArn, aws.StringValue(status.RequestId))
+	_, err = waitAccountAssignmentCreated(ctx, conn, instanceArn, aws.StringValue(status.RequestId), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for SSO Account Assignment for %s (%s) to be created: %s", principalType, principalID, err)
 	}
@@ -202,7 +215,10 @@ func resourceAccountAssignmentDelete(ctx context.Context, d *schema.ResourceData
 		PrincipalType:    aws.String(principalType),
 	}
 
-	output, err := conn.DeleteAccountAssignmentWithContext(ctx, input)
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteAccountAssignmentWithContext(ctx, input)
+	}, ssoadmin.ErrCodeConflictException, ssoadmin.ErrCodeThrottlingException)
+
 	if err != nil {
 		if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
 			return diags
@@ -210,13 +226,15 @@ func resourceAccountAssignmentDelete(ctx context.Context, d *schema.ResourceData
 		return sdkdiag.AppendErrorf(diags, "deleting SSO Account Assignment for Principal (%s): %s", principalID, err)
 	}
 
+	output := outputRaw.(*ssoadmin.DeleteAccountAssignmentOutput)
+
 	if output == nil || output.AccountAssignmentDeletionStatus == nil {
 		return sdkdiag.AppendErrorf(diags, "deleting SSO Account Assignment for Principal (%s): empty output", principalID)
 	}
 
 	status := output.AccountAssignmentDeletionStatus
 
-	_, err = waitAccountAssignmentDeleted(ctx, conn, instanceArn, aws.StringValue(status.RequestId))
+	_, err = waitAccountAssignmentDeleted(ctx, conn, instanceArn, aws.StringValue(status.RequestId), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for SSO Account Assignment for Principal (%s) to be deleted: %s", principalID, err)
 	}
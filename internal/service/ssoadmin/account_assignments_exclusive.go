@@ -0,0 +1,316 @@
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAccountAssignmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccountAssignmentsExclusivePut,
+		ReadWithoutTimeout:   resourceAccountAssignmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceAccountAssignmentsExclusivePut,
+		DeleteWithoutTimeout: resourceAccountAssignmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"permission_set_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"principal": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principal_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 47),
+								validation.StringMatch(regexp.MustCompile(`^([0-9a-f]{10}-|)[A-Fa-f0-9]{8}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{12}$`), "must match ([0-9a-f]{10}-|)[A-Fa-f0-9]{8}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{12}"),
+							),
+						},
+						"principal_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(ssoadmin.PrincipalType_Values(), false),
+						},
+					},
+				},
+			},
+			"target_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"target_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ssoadmin.TargetTypeAwsAccount,
+				ValidateFunc: validation.StringInSlice(ssoadmin.TargetType_Values(), false),
+			},
+		},
+	}
+}
+
+const accountAssignmentsExclusiveIDSeparator = ","
+
+func AccountAssignmentsExclusiveCreateResourceID(targetID, targetType, permissionSetARN, instanceARN string) string {
+	parts := []string{targetID, targetType, permissionSetARN, instanceARN}
+	id := strings.Join(parts, accountAssignmentsExclusiveIDSeparator)
+
+	return id
+}
+
+func AccountAssignmentsExclusiveParseResourceID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, accountAssignmentsExclusiveIDSeparator)
+
+	if len(parts) == 4 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" {
+		return parts[0], parts[1], parts[2], parts[3], nil
+	}
+
+	return "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TARGET_ID%[2]sTARGET_TYPE%[2]sPERMISSION_SET_ARN%[2]sINSTANCE_ARN", id, accountAssignmentsExclusiveIDSeparator)
+}
+
+func resourceAccountAssignmentsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
+
+	targetID := d.Get("target_id").(string)
+	targetType := d.Get("target_type").(string)
+	permissionSetARN := d.Get("permission_set_arn").(string)
+	instanceARN := d.Get("instance_arn").(string)
+	id := AccountAssignmentsExclusiveCreateResourceID(targetID, targetType, permissionSetARN, instanceARN)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	// Reconcile against the assignments that currently exist so that
+	// principals assigned outside of Terraform are removed.
+	assignments, err := FindAccountAssignments(ctx, conn, targetID, permissionSetARN, instanceARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSO Account Assignments (%s): %s", id, err)
+	}
+
+	have := make(map[string]*ssoadmin.AccountAssignment)
+	for _, v := range assignments {
+		have[accountAssignmentPrincipalKey(aws.StringValue(v.PrincipalType), aws.StringValue(v.PrincipalId))] = v
+	}
+
+	want := make(map[string]map[string]interface{})
+	for _, v := range d.Get("principal").(*schema.Set).List() {
+		tfMap := v.(map[string]interface{})
+		want[accountAssignmentPrincipalKey(tfMap["principal_type"].(string), tfMap["principal_id"].(string))] = tfMap
+	}
+
+	// Submit every request before waiting so that the service can process the assignments for this permission set as a batch.
+	var createRequestIDs, deleteRequestIDs []string
+
+	for k, tfMap := range want {
+		if _, ok := have[k]; ok {
+			continue
+		}
+
+		input := &ssoadmin.CreateAccountAssignmentInput{
+			InstanceArn:      aws.String(instanceARN),
+			PermissionSetArn: aws.String(permissionSetARN),
+			PrincipalId:      aws.String(tfMap["principal_id"].(string)),
+			PrincipalType:    aws.String(tfMap["principal_type"].(string)),
+			TargetId:         aws.String(targetID),
+			TargetType:       aws.String(targetType),
+		}
+
+		outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+			return conn.CreateAccountAssignmentWithContext(ctx, input)
+		}, ssoadmin.ErrCodeConflictException, ssoadmin.ErrCodeThrottlingException)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating SSO Account Assignment (%s) for %s: %s", id, k, err)
+		}
+
+		createRequestIDs = append(createRequestIDs, aws.StringValue(outputRaw.(*ssoadmin.CreateAccountAssignmentOutput).AccountAssignmentCreationStatus.RequestId))
+	}
+
+	for k, v := range have {
+		if _, ok := want[k]; ok {
+			continue
+		}
+
+		requestID, err := deleteAccountAssignment(ctx, conn, v, targetType, instanceARN, timeout)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting SSO Account Assignment (%s) for %s: %s", id, k, err)
+		}
+
+		if requestID != "" {
+			deleteRequestIDs = append(deleteRequestIDs, requestID)
+		}
+	}
+
+	d.SetId(id)
+
+	for _, requestID := range createRequestIDs {
+		if _, err := waitAccountAssignmentCreated(ctx, conn, instanceARN, requestID, timeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SSO Account Assignment (%s) create: %s", id, err)
+		}
+	}
+
+	for _, requestID := range deleteRequestIDs {
+		if _, err := waitAccountAssignmentDeleted(ctx, conn, instanceARN, requestID, timeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SSO Account Assignment (%s) delete: %s", id, err)
+		}
+	}
+
+	return append(diags, resourceAccountAssignmentsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceAccountAssignmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
+
+	targetID, targetType, permissionSetARN, instanceARN, err := AccountAssignmentsExclusiveParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSO Account Assignments (%s): %s", d.Id(), err)
+	}
+
+	assignments, err := FindAccountAssignments(ctx, conn, targetID, permissionSetARN, instanceARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Account Assignments (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSO Account Assignments (%s): %s", d.Id(), err)
+	}
+
+	var principals []interface{}
+	for _, v := range assignments {
+		principals = append(principals, map[string]interface{}{
+			"principal_id":   aws.StringValue(v.PrincipalId),
+			"principal_type": aws.StringValue(v.PrincipalType),
+		})
+	}
+
+	d.Set("instance_arn", instanceARN)
+	d.Set("permission_set_arn", permissionSetARN)
+	if err := d.Set("principal", principals); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting principal: %s", err)
+	}
+	d.Set("target_id", targetID)
+	d.Set("target_type", targetType)
+
+	return diags
+}
+
+func resourceAccountAssignmentsExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
+
+	targetID, targetType, permissionSetARN, instanceARN, err := AccountAssignmentsExclusiveParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSO Account Assignments (%s): %s", d.Id(), err)
+	}
+
+	var requestIDs []string
+
+	for _, v := range d.Get("principal").(*schema.Set).List() {
+		tfMap := v.(map[string]interface{})
+		assignment := &ssoadmin.AccountAssignment{
+			AccountId:        aws.String(targetID),
+			PermissionSetArn: aws.String(permissionSetARN),
+			PrincipalId:      aws.String(tfMap["principal_id"].(string)),
+			PrincipalType:    aws.String(tfMap["principal_type"].(string)),
+		}
+
+		requestID, err := deleteAccountAssignment(ctx, conn, assignment, targetType, instanceARN, d.Timeout(schema.TimeoutDelete))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting SSO Account Assignments (%s): %s", d.Id(), err)
+		}
+
+		if requestID != "" {
+			requestIDs = append(requestIDs, requestID)
+		}
+	}
+
+	for _, requestID := range requestIDs {
+		if _, err := waitAccountAssignmentDeleted(ctx, conn, instanceARN, requestID, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SSO Account Assignments (%s) delete: %s", d.Id(), err)
+		}
+	}
+
+	return diags
+}
+
+// deleteAccountAssignment submits the deletion of an account assignment and returns the ID of the deletion request.
+// An empty request ID is returned if the assignment does not exist.
+func deleteAccountAssignment(ctx context.Context, conn *ssoadmin.SSOAdmin, assignment *ssoadmin.AccountAssignment, targetType, instanceARN string, timeout time.Duration) (string, error) {
+	input := &ssoadmin.DeleteAccountAssignmentInput{
+		InstanceArn:      aws.String(instanceARN),
+		PermissionSetArn: assignment.PermissionSetArn,
+		PrincipalId:      assignment.PrincipalId,
+		PrincipalType:    assignment.PrincipalType,
+		TargetId:         assignment.AccountId,
+		TargetType:       aws.String(targetType),
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+		return conn.DeleteAccountAssignmentWithContext(ctx, input)
+	}, ssoadmin.ErrCodeConflictException, ssoadmin.ErrCodeThrottlingException)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(outputRaw.(*ssoadmin.DeleteAccountAssignmentOutput).AccountAssignmentDeletionStatus.RequestId), nil
+}
+
+func accountAssignmentPrincipalKey(principalType, principalID string) string {
+	return principalType + "/" + principalID
+}
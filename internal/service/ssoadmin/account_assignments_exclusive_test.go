@@ -0,0 +1,207 @@
+package ssoadmin_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSOAdminAccountAssignmentsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_account_assignments_exclusive.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+	userName := os.Getenv("AWS_IDENTITY_STORE_USER_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckInstances(ctx, t)
+			testAccPreCheckIdentityStoreGroupName(t)
+			testAccPreCheckIdentityStoreUserName(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountAssignmentsExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentsExclusiveConfig_group(groupName, userName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "principal.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "principal.*", map[string]string{
+						"principal_type": "GROUP",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "target_type", "AWS_ACCOUNT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountAssignmentsExclusiveConfig_groupAndUser(groupName, userName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "principal.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "principal.*", map[string]string{
+						"principal_type": "GROUP",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "principal.*", map[string]string{
+						"principal_type": "USER",
+					}),
+				),
+			},
+			{
+				Config: testAccAccountAssignmentsExclusiveConfig_empty(groupName, userName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExclusiveCount(ctx, resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "principal.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccountAssignmentsExclusiveDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssoadmin_account_assignments_exclusive" {
+				continue
+			}
+
+			targetID, _, permissionSetARN, instanceARN, err := tfssoadmin.AccountAssignmentsExclusiveParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			assignments, err := tfssoadmin.FindAccountAssignments(ctx, conn, targetID, permissionSetARN, instanceARN)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(assignments) > 0 {
+				return fmt.Errorf("SSO Account Assignments %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccountAssignmentsExclusiveCount(ctx context.Context, n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSO Account Assignments ID is set")
+		}
+
+		targetID, _, permissionSetARN, instanceARN, err := tfssoadmin.AccountAssignmentsExclusiveParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn()
+
+		assignments, err := tfssoadmin.FindAccountAssignments(ctx, conn, targetID, permissionSetARN, instanceARN)
+
+		if err != nil {
+			return err
+		}
+
+		if actual := len(assignments); actual != expected {
+			return fmt.Errorf("SSO Account Assignments %s: expected %d principals, got %d", rs.Primary.ID, expected, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccAccountAssignmentsExclusiveConfig_base(groupName, userName, rName string) string {
+	return acctest.ConfigCompose(testAccAccountAssignmentBaseConfig(rName), fmt.Sprintf(`
+data "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  filter {
+    attribute_path  = "DisplayName"
+    attribute_value = %[1]q
+  }
+}
+
+data "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  filter {
+    attribute_path  = "UserName"
+    attribute_value = %[2]q
+  }
+}
+`, groupName, userName))
+}
+
+func testAccAccountAssignmentsExclusiveConfig_group(groupName, userName, rName string) string {
+	return acctest.ConfigCompose(testAccAccountAssignmentsExclusiveConfig_base(groupName, userName, rName), `
+resource "aws_ssoadmin_account_assignments_exclusive" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+  target_id          = data.aws_caller_identity.current.account_id
+
+  principal {
+    principal_id   = data.aws_identitystore_group.test.group_id
+    principal_type = "GROUP"
+  }
+}
+`)
+}
+
+func testAccAccountAssignmentsExclusiveConfig_groupAndUser(groupName, userName, rName string) string {
+	return acctest.ConfigCompose(testAccAccountAssignmentsExclusiveConfig_base(groupName, userName, rName), `
+resource "aws_ssoadmin_account_assignments_exclusive" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+  target_id          = data.aws_caller_identity.current.account_id
+
+  principal {
+    principal_id   = data.aws_identitystore_group.test.group_id
+    principal_type = "GROUP"
+  }
+
+  principal {
+    principal_id   = data.aws_identitystore_user.test.user_id
+    principal_type = "USER"
+  }
+}
+`)
+}
+
+func testAccAccountAssignmentsExclusiveConfig_empty(groupName, userName, rName string) string {
+	return acctest.ConfigCompose(testAccAccountAssignmentsExclusiveConfig_base(groupName, userName, rName), `
+resource "aws_ssoadmin_account_assignments_exclusive" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+  target_id          = data.aws_caller_identity.current.account_id
+}
+`)
+}
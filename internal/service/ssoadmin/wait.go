@@ -2,51 +2,60 @@ package ssoadmin
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
-	accountAssignmentCreateTimeout      = 5 * time.Minute
-	accountAssignmentDeleteTimeout      = 5 * time.Minute
 	accountAssignmentDelay              = 5 * time.Second
 	accountAssignmentMinTimeout         = 3 * time.Second
 	permissionSetProvisioningRetryDelay = 5 * time.Second
 	permissionSetProvisionTimeout       = 10 * time.Minute
 )
 
-func waitAccountAssignmentCreated(ctx context.Context, conn *ssoadmin.SSOAdmin, instanceArn, requestID string) (*ssoadmin.AccountAssignmentOperationStatus, error) {
+func waitAccountAssignmentCreated(ctx context.Context, conn *ssoadmin.SSOAdmin, instanceArn, requestID string, timeout time.Duration) (*ssoadmin.AccountAssignmentOperationStatus, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{ssoadmin.StatusValuesInProgress},
 		Target:     []string{ssoadmin.StatusValuesSucceeded},
 		Refresh:    statusAccountAssignmentCreation(ctx, conn, instanceArn, requestID),
-		Timeout:    accountAssignmentCreateTimeout,
+		Timeout:    timeout,
 		Delay:      accountAssignmentDelay,
 		MinTimeout: accountAssignmentMinTimeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if v, ok := outputRaw.(*ssoadmin.AccountAssignmentOperationStatus); ok {
+		if status := aws.StringValue(v.Status); status == ssoadmin.StatusValuesFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.FailureReason)))
+		}
+
 		return v, err
 	}
 
 	return nil, err
 }
 
-func waitAccountAssignmentDeleted(ctx context.Context, conn *ssoadmin.SSOAdmin, instanceArn, requestID string) (*ssoadmin.AccountAssignmentOperationStatus, error) {
+func waitAccountAssignmentDeleted(ctx context.Context, conn *ssoadmin.SSOAdmin, instanceArn, requestID string, timeout time.Duration) (*ssoadmin.AccountAssignmentOperationStatus, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{ssoadmin.StatusValuesInProgress},
 		Target:     []string{ssoadmin.StatusValuesSucceeded},
 		Refresh:    statusAccountAssignmentDeletion(ctx, conn, instanceArn, requestID),
-		Timeout:    accountAssignmentDeleteTimeout,
+		Timeout:    timeout,
 		Delay:      accountAssignmentDelay,
 		MinTimeout: accountAssignmentMinTimeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if v, ok := outputRaw.(*ssoadmin.AccountAssignmentOperationStatus); ok {
+		if status := aws.StringValue(v.Status); status == ssoadmin.StatusValuesFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.FailureReason)))
+		}
+
 		return v, err
 	}
 
@@ -63,6 +72,10 @@ func waitPermissionSetProvisioned(ctx context.Context, conn *ssoadmin.SSOAdmin,
 	}
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if v, ok := outputRaw.(*ssoadmin.PermissionSetProvisioningStatus); ok {
+		if status := aws.StringValue(v.Status); status == ssoadmin.StatusValuesFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.FailureReason)))
+		}
+
 		return v, err
 	}
 	return nil, err
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -67,7 +68,10 @@ func (d *dataSourceCallerIdentity) Read(ctx context.Context, request datasource.
 
 	conn := d.Meta().STSConn()
 
-	output, err := FindCallerIdentity(ctx, conn)
+	// The caller identity cannot change for a given provider configuration.
+	outputRaw, err := d.Meta().Memoize("sts:GetCallerIdentity", func() (any, error) {
+		return FindCallerIdentity(ctx, conn)
+	})
 
 	if err != nil {
 		response.Diagnostics.AddError("reading STS Caller Identity", err.Error())
@@ -75,6 +79,8 @@ func (d *dataSourceCallerIdentity) Read(ctx context.Context, request datasource.
 		return
 	}
 
+	output := outputRaw.(*sts.GetCallerIdentityOutput)
+
 	accountID := aws.StringValue(output.Account)
 	data.AccountID = types.StringValue(accountID)
 	data.ARN = flex.StringToFrameworkLegacy(ctx, output.Arn)
@@ -0,0 +1,9 @@
+package timestreamquery
+
+import (
+	"time"
+)
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
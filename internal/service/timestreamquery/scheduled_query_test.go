@@ -0,0 +1,377 @@
+package timestreamquery_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/timestreamquery"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftimestreamquery "github.com/hashicorp/terraform-provider-aws/internal/service/timestreamquery"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTimestreamQueryScheduledQuery_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreamquery_scheduled_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, timestreamquery.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledQueryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledQueryConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "timestream", regexp.MustCompile(`scheduled-query/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "error_report_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "error_report_configuration.0.s3_configuration.0.bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "error_report_configuration.0.s3_configuration.0.object_key_prefix", "errors/"),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "notification_configuration.0.sns_configuration.0.topic_arn", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_configuration.0.schedule_expression", "rate(1 hour)"),
+					resource.TestCheckResourceAttr(resourceName, "state", timestreamquery.ScheduledQueryStateEnabled),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.dimension_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.multi_measure_mappings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.time_column", "binned_timestamp"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTimestreamQueryScheduledQuery_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreamquery_scheduled_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, timestreamquery.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledQueryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledQueryConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tftimestreamquery.ResourceScheduledQuery(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTimestreamQueryScheduledQuery_state(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreamquery_scheduled_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, timestreamquery.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledQueryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledQueryConfig_state(rName, timestreamquery.ScheduledQueryStateDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", timestreamquery.ScheduledQueryStateDisabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduledQueryConfig_state(rName, timestreamquery.ScheduledQueryStateEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", timestreamquery.ScheduledQueryStateEnabled),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTimestreamQueryScheduledQuery_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreamquery_scheduled_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, timestreamquery.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledQueryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledQueryConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduledQueryConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccScheduledQueryConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckScheduledQueryDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TimestreamQueryConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_timestreamquery_scheduled_query" {
+				continue
+			}
+
+			_, err := tftimestreamquery.FindScheduledQueryByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Timestream Query Scheduled Query %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckScheduledQueryExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Timestream Query Scheduled Query ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TimestreamQueryConn()
+
+		_, err := tftimestreamquery.FindScheduledQueryByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TimestreamQueryConn()
+
+	input := &timestreamquery.ListScheduledQueriesInput{}
+
+	_, err := conn.ListScheduledQueriesWithContext(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccScheduledQueryConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_timestreamwrite_database" "test" {
+  database_name = %[1]q
+}
+
+resource "aws_timestreamwrite_table" "source" {
+  database_name = aws_timestreamwrite_database.test.database_name
+  table_name    = "%[1]s-source"
+}
+
+resource "aws_timestreamwrite_table" "target" {
+  database_name = aws_timestreamwrite_database.test.database_name
+  table_name    = "%[1]s-target"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = "timestream.${data.aws_partition.current.dns_suffix}" }
+      Action    = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect = "Allow"
+        Action = [
+          "timestream:DescribeEndpoints",
+          "timestream:Select",
+          "timestream:SelectValues",
+          "timestream:WriteRecords",
+        ]
+        Resource = "*"
+      },
+      {
+        Effect   = "Allow"
+        Action   = ["s3:PutObject", "s3:GetBucketAcl"]
+        Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+      },
+      {
+        Effect   = "Allow"
+        Action   = "sns:Publish"
+        Resource = aws_sns_topic.test.arn
+      },
+    ]
+  })
+}
+`, rName)
+}
+
+func testAccScheduledQueryConfig_resource(rName, extra string) string {
+	return acctest.ConfigCompose(testAccScheduledQueryConfig_base(rName), fmt.Sprintf(`
+resource "aws_timestreamquery_scheduled_query" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test.arn
+
+  query_string = <<EOF
+SELECT region, bin(time, 1h) AS binned_timestamp, avg(measure_value::double) AS avg_value
+FROM "${aws_timestreamwrite_database.test.database_name}"."${aws_timestreamwrite_table.source.table_name}"
+WHERE time BETWEEN @scheduled_runtime - 1h AND @scheduled_runtime
+GROUP BY region, bin(time, 1h)
+EOF
+
+  error_report_configuration {
+    s3_configuration {
+      bucket_name       = aws_s3_bucket.test.bucket
+      object_key_prefix = "errors/"
+    }
+  }
+
+  notification_configuration {
+    sns_configuration {
+      topic_arn = aws_sns_topic.test.arn
+    }
+  }
+
+  schedule_configuration {
+    schedule_expression = "rate(1 hour)"
+  }
+
+  target_configuration {
+    timestream_configuration {
+      database_name = aws_timestreamwrite_database.test.database_name
+      table_name    = aws_timestreamwrite_table.target.table_name
+      time_column   = "binned_timestamp"
+
+      dimension_mapping {
+        name                 = "region"
+        dimension_value_type = "VARCHAR"
+      }
+
+      multi_measure_mappings {
+        target_multi_measure_name = "metrics"
+
+        multi_measure_attribute_mapping {
+          source_column      = "avg_value"
+          measure_value_type = "DOUBLE"
+        }
+      }
+    }
+  }
+
+%[2]s
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, extra))
+}
+
+func testAccScheduledQueryConfig_basic(rName string) string {
+	return testAccScheduledQueryConfig_resource(rName, "")
+}
+
+func testAccScheduledQueryConfig_state(rName, state string) string {
+	return testAccScheduledQueryConfig_resource(rName, fmt.Sprintf(`  state = %[1]q`, state))
+}
+
+func testAccScheduledQueryConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return testAccScheduledQueryConfig_resource(rName, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+  }
+`, tagKey1, tagValue1))
+}
+
+func testAccScheduledQueryConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccScheduledQueryConfig_resource(rName, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,66 @@
+//go:build sweep
+// +build sweep
+
+package timestreamquery
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamquery"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_timestreamquery_scheduled_query", &resource.Sweeper{
+		Name: "aws_timestreamquery_scheduled_query",
+		F:    sweepScheduledQueries,
+	})
+}
+
+func sweepScheduledQueries(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	input := &timestreamquery.ListScheduledQueriesInput{}
+	conn := client.(*conns.AWSClient).TimestreamQueryConn()
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListScheduledQueriesPagesWithContext(ctx, input, func(page *timestreamquery.ListScheduledQueriesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ScheduledQueries {
+			r := ResourceScheduledQuery()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Timestream Query Scheduled Query sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Timestream Query Scheduled Queries (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Timestream Query Scheduled Queries (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -45,6 +45,7 @@ func ResourceServer() *schema.Resource {
 
 				return false
 			}),
+			customizeDiffIdentityProviderDetails,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -946,3 +947,33 @@ func updateServer(ctx context.Context, conn *transfer.Transfer, input *transfer.
 
 	return nil
 }
+
+func customizeDiffIdentityProviderDetails(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("identity_provider_type") {
+		return nil
+	}
+
+	// Required arguments for each custom identity provider type.
+	var required []string
+
+	identityProviderType := diff.Get("identity_provider_type").(string)
+
+	switch identityProviderType {
+	case transfer.IdentityProviderTypeApiGateway:
+		required = []string{"invocation_role", "url"}
+	case transfer.IdentityProviderTypeAwsLambda:
+		required = []string{"function"}
+	}
+
+	for _, key := range required {
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+
+		if v := diff.Get(key).(string); v == "" {
+			return fmt.Errorf("%q must be set when identity_provider_type is %q", key, identityProviderType)
+		}
+	}
+
+	return nil
+}
@@ -977,6 +977,28 @@ func testAccServer_lambdaFunction(t *testing.T) {
 	})
 }
 
+func testAccServer_identityProviderValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServerConfig_identityProviderTypeMissingDetails(rName, "API_GATEWAY"),
+				ExpectError: regexp.MustCompile(`"invocation_role" must be set when identity_provider_type is "API_GATEWAY"`),
+			},
+			{
+				Config:      testAccServerConfig_identityProviderTypeMissingDetails(rName, "AWS_LAMBDA"),
+				ExpectError: regexp.MustCompile(`"function" must be set when identity_provider_type is "AWS_LAMBDA"`),
+			},
+		},
+	})
+}
+
 func testAccServer_authenticationLoginBanners(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf transfer.DescribedServer
@@ -1696,6 +1718,18 @@ resource "aws_transfer_server" "test" {
 `, rName, forceDestroy))
 }
 
+func testAccServerConfig_identityProviderTypeMissingDetails(rName, identityProviderType string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_server" "test" {
+  identity_provider_type = %[2]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, identityProviderType)
+}
+
 func testAccServerConfig_workflow(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "test" {
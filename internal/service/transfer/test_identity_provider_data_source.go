@@ -0,0 +1,101 @@
+package transfer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourceTestIdentityProvider() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTestIdentityProviderRead,
+
+		Schema: map[string]*schema.Schema{
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"response": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"server_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(19, 19),
+			},
+			"server_protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(transfer.Protocol_Values(), false),
+			},
+			"source_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"status_code": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceTestIdentityProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferConn()
+
+	serverID := d.Get("server_id").(string)
+	userName := d.Get("user_name").(string)
+	input := &transfer.TestIdentityProviderInput{
+		ServerId: aws.String(serverID),
+		UserName: aws.String(userName),
+	}
+
+	if v, ok := d.GetOk("server_protocol"); ok {
+		input.ServerProtocol = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_ip"); ok {
+		input.SourceIp = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("user_password"); ok {
+		input.UserPassword = aws.String(v.(string))
+	}
+
+	output, err := conn.TestIdentityProviderWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "testing Transfer Server (%s) identity provider for user (%s): %s", serverID, userName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serverID, userName))
+	d.Set("message", output.Message)
+	d.Set("response", output.Response)
+	d.Set("status_code", output.StatusCode)
+	d.Set("url", output.Url)
+
+	return diags
+}
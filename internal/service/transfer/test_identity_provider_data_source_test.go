@@ -0,0 +1,47 @@
+package transfer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transfer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccTransferTestIdentityProviderDataSource_lambdaFunction(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transfer_server.test"
+	dataSourceName := "data.aws_transfer_test_identity_provider.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTestIdentityProviderDataSourceConfig_lambdaFunction(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "server_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "user_name", "test"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "status_code"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTestIdentityProviderDataSourceConfig_lambdaFunction(rName string) string {
+	return acctest.ConfigCompose(testAccServerConfig_lambdaFunctionIdentityProviderType(rName, false), fmt.Sprintf(`
+data "aws_transfer_test_identity_provider" "test" {
+  server_id       = aws_transfer_server.test.id
+  server_protocol = "SFTP"
+  source_ip       = "127.0.0.1"
+  user_name       = "test"
+  user_password   = %[1]q
+}
+`, rName))
+}
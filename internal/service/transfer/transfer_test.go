@@ -26,6 +26,7 @@ func TestAccTransfer_serial(t *testing.T) {
 			"Domain":                        testAccServer_domain,
 			"ForceDestroy":                  testAccServer_forceDestroy,
 			"HostKey":                       testAccServer_hostKey,
+			"IdentityProviderValidation":    testAccServer_identityProviderValidation,
 			"LambdaFunction":                testAccServer_lambdaFunction,
 			"Protocols":                     testAccServer_protocols,
 			"SecurityPolicy":                testAccServer_securityPolicy,
@@ -0,0 +1,469 @@
+package translate
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceParallelData() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceParallelDataCreate,
+		ReadWithoutTimeout:   resourceParallelDataRead,
+		UpdateWithoutTimeout: resourceParallelDataUpdate,
+		DeleteWithoutTimeout: resourceParallelDataDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"encryption_key": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 400),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(translate.EncryptionKeyType_Values(), false),
+						},
+					},
+				},
+			},
+			"failed_record_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"imported_record_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^([A-Za-z0-9-]_?)+$`), "must contain only alphanumeric characters, hyphens and single underscores"),
+				),
+			},
+			"parallel_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(translate.ParallelDataFormat_Values(), false),
+						},
+						"s3_uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^s3://`), "must be an S3 URI"),
+						},
+					},
+				},
+			},
+			"skipped_record_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"source_language_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target_language_codes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceParallelDataCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TranslateConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &translate.CreateParallelDataInput{
+		Name:               aws.String(name),
+		ParallelDataConfig: expandParallelDataConfig(d.Get("parallel_data_config").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_key"); ok {
+		input.EncryptionKey = expandEncryptionKey(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateParallelDataWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Translate Parallel Data (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitParallelDataCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Translate Parallel Data (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceParallelDataRead(ctx, d, meta)...)
+}
+
+func resourceParallelDataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TranslateConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindParallelDataByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Translate Parallel Data (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Translate Parallel Data (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.Set("arn", arn)
+	d.Set("description", output.Description)
+	if err := d.Set("encryption_key", flattenEncryptionKey(output.EncryptionKey)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting encryption_key: %s", err)
+	}
+	d.Set("failed_record_count", output.FailedRecordCount)
+	d.Set("imported_record_count", output.ImportedRecordCount)
+	d.Set("name", output.Name)
+	if err := d.Set("parallel_data_config", flattenParallelDataConfig(output.ParallelDataConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting parallel_data_config: %s", err)
+	}
+	d.Set("skipped_record_count", output.SkippedRecordCount)
+	d.Set("source_language_code", output.SourceLanguageCode)
+	d.Set("status", output.Status)
+	d.Set("target_language_codes", aws.StringValueSlice(output.TargetLanguageCodes))
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Translate Parallel Data (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceParallelDataUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TranslateConn()
+
+	if d.HasChanges("description", "parallel_data_config") {
+		input := &translate.UpdateParallelDataInput{
+			Description:        aws.String(d.Get("description").(string)),
+			Name:               aws.String(d.Id()),
+			ParallelDataConfig: expandParallelDataConfig(d.Get("parallel_data_config").([]interface{})),
+		}
+
+		_, err := conn.UpdateParallelDataWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Translate Parallel Data (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitParallelDataUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Translate Parallel Data (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Translate Parallel Data (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceParallelDataRead(ctx, d, meta)...)
+}
+
+func resourceParallelDataDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TranslateConn()
+
+	log.Printf("[DEBUG] Deleting Translate Parallel Data: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteParallelDataWithContext(ctx, &translate.DeleteParallelDataInput{
+			Name: aws.String(d.Id()),
+		})
+	}, translate.ErrCodeConcurrentModificationException)
+
+	if tfawserr.ErrCodeEquals(err, translate.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Translate Parallel Data (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitParallelDataDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Translate Parallel Data (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindParallelDataByName(ctx context.Context, conn *translate.Translate, name string) (*translate.ParallelDataProperties, error) {
+	input := &translate.GetParallelDataInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetParallelDataWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, translate.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ParallelDataProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ParallelDataProperties, nil
+}
+
+func statusParallelData(ctx context.Context, conn *translate.Translate, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindParallelDataByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// statusParallelDataLatestUpdateAttempt tracks the outcome of the most recent update,
+// as the parallel data itself stays ACTIVE while new data is being imported.
+func statusParallelDataLatestUpdateAttempt(ctx context.Context, conn *translate.Translate, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindParallelDataByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.LatestUpdateAttemptStatus), nil
+	}
+}
+
+func waitParallelDataCreated(ctx context.Context, conn *translate.Translate, name string, timeout time.Duration) (*translate.ParallelDataProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{translate.ParallelDataStatusCreating},
+		Target:  []string{translate.ParallelDataStatusActive},
+		Refresh: statusParallelData(ctx, conn, name),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*translate.ParallelDataProperties); ok {
+		if status := aws.StringValue(output.Status); status == translate.ParallelDataStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitParallelDataUpdated(ctx context.Context, conn *translate.Translate, name string, timeout time.Duration) (*translate.ParallelDataProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{translate.ParallelDataStatusUpdating},
+		Target:  []string{translate.ParallelDataStatusActive},
+		Refresh: statusParallelDataLatestUpdateAttempt(ctx, conn, name),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*translate.ParallelDataProperties); ok {
+		if status := aws.StringValue(output.LatestUpdateAttemptStatus); status == translate.ParallelDataStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitParallelDataDeleted(ctx context.Context, conn *translate.Translate, name string, timeout time.Duration) (*translate.ParallelDataProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{translate.ParallelDataStatusActive, translate.ParallelDataStatusDeleting},
+		Target:  []string{},
+		Refresh: statusParallelData(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*translate.ParallelDataProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandParallelDataConfig(tfList []interface{}) *translate.ParallelDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &translate.ParallelDataConfig{}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_uri"].(string); ok && v != "" {
+		apiObject.S3Uri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenParallelDataConfig(apiObject *translate.ParallelDataConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"format": aws.StringValue(apiObject.Format),
+		"s3_uri": aws.StringValue(apiObject.S3Uri),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandEncryptionKey(tfList []interface{}) *translate.EncryptionKey {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &translate.EncryptionKey{}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		apiObject.Id = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenEncryptionKey(apiObject *translate.EncryptionKey) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"id":   aws.StringValue(apiObject.Id),
+		"type": aws.StringValue(apiObject.Type),
+	}
+
+	return []interface{}{tfMap}
+}
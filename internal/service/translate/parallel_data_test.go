@@ -0,0 +1,353 @@
+package translate_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/translate"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftranslate "github.com/hashicorp/terraform-provider-aws/internal/service/translate"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTranslateParallelData_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v translate.ParallelDataProperties
+	resourceName := "aws_translate_parallel_data.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, translate.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "translate", regexp.MustCompile(`parallel-data/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "encryption_key.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "imported_record_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.0.format", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "source_language_code", "en"),
+					resource.TestCheckResourceAttr(resourceName, "status", translate.ParallelDataStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_language_codes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_language_codes.0", "fr"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTranslateParallelData_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v translate.ParallelDataProperties
+	resourceName := "aws_translate_parallel_data.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, translate.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tftranslate.ResourceParallelData(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTranslateParallelData_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v translate.ParallelDataProperties
+	resourceName := "aws_translate_parallel_data.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, translate.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "imported_record_count", "2"),
+				),
+			},
+			{
+				Config: testAccParallelDataConfig_updated(rName, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
+					resource.TestCheckResourceAttr(resourceName, "imported_record_count", "3"),
+					resource.TestMatchResourceAttr(resourceName, "parallel_data_config.0.s3_uri", regexp.MustCompile(`/parallel-data-updated\.csv$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranslateParallelData_encryptionKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v translate.ParallelDataProperties
+	resourceName := "aws_translate_parallel_data.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, translate.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_encryptionKey(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "encryption_key.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_key.0.id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_key.0.type", translate.EncryptionKeyTypeKms),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTranslateParallelData_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v translate.ParallelDataProperties
+	resourceName := "aws_translate_parallel_data.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, translate.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccParallelDataConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccParallelDataConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckParallelDataDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_translate_parallel_data" {
+				continue
+			}
+
+			_, err := tftranslate.FindParallelDataByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Translate Parallel Data %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParallelDataExists(ctx context.Context, n string, v *translate.ParallelDataProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Translate Parallel Data ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateConn()
+
+		output, err := tftranslate.FindParallelDataByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccParallelDataConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "parallel-data.csv"
+  content = <<-EOT
+en,fr
+Hello,Bonjour
+Goodbye,Au revoir
+EOT
+}
+`, rName)
+}
+
+func testAccParallelDataConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccParallelDataConfig_base(rName), fmt.Sprintf(`
+resource "aws_translate_parallel_data" "test" {
+  name = %[1]q
+
+  parallel_data_config {
+    format = "CSV"
+    s3_uri = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+}
+`, rName))
+}
+
+func testAccParallelDataConfig_updated(rName, description string) string {
+	return acctest.ConfigCompose(testAccParallelDataConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_object" "updated" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "parallel-data-updated.csv"
+  content = <<-EOT
+en,fr
+Hello,Bonjour
+Goodbye,Au revoir
+Good night,Bonne nuit
+EOT
+}
+
+resource "aws_translate_parallel_data" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  parallel_data_config {
+    format = "CSV"
+    s3_uri = "s3://${aws_s3_object.updated.bucket}/${aws_s3_object.updated.key}"
+  }
+}
+`, rName, description))
+}
+
+func testAccParallelDataConfig_encryptionKey(rName string) string {
+	return acctest.ConfigCompose(testAccParallelDataConfig_base(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_translate_parallel_data" "test" {
+  name = %[1]q
+
+  encryption_key {
+    id   = aws_kms_key.test.arn
+    type = "KMS"
+  }
+
+  parallel_data_config {
+    format = "CSV"
+    s3_uri = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+}
+`, rName))
+}
+
+func testAccParallelDataConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccParallelDataConfig_base(rName), fmt.Sprintf(`
+resource "aws_translate_parallel_data" "test" {
+  name = %[1]q
+
+  parallel_data_config {
+    format = "CSV"
+    s3_uri = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccParallelDataConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccParallelDataConfig_base(rName), fmt.Sprintf(`
+resource "aws_translate_parallel_data" "test" {
+  name = %[1]q
+
+  parallel_data_config {
+    format = "CSV"
+    s3_uri = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,9 @@
+package workmail
+
+const (
+	organizationStateActive    = "Active"
+	organizationStateCreating  = "Creating"
+	organizationStateDeleted   = "Deleted"
+	organizationStateDeleting  = "Deleting"
+	organizationStateRequested = "Requested"
+)
@@ -0,0 +1,214 @@
+package workmail
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceDomain() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDomainCreate,
+		ReadWithoutTimeout:   resourceDomainRead,
+		UpdateWithoutTimeout: resourceDomainUpdate,
+		DeleteWithoutTimeout: resourceDomainDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"dkim_verification_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 209),
+			},
+			"is_test_domain": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ownership_verification_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+
+	organizationID := d.Get("organization_id").(string)
+	domainName := d.Get("domain_name").(string)
+	id := DomainCreateResourceID(organizationID, domainName)
+	input := &workmail.RegisterMailDomainInput{
+		DomainName:     aws.String(domainName),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	log.Printf("[DEBUG] Registering WorkMail Domain: %s", input)
+	_, err := conn.RegisterMailDomainWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("registering WorkMail Domain (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if d.Get("default").(bool) {
+		if err := updateDefaultMailDomain(ctx, conn, organizationID, domainName); err != nil {
+			return diag.Errorf("setting WorkMail Domain (%s) as default: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDomainRead(ctx, d, meta)
+}
+
+func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+
+	organizationID, domainName, err := DomainParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domain, err := FindMailDomainByTwoPartKey(ctx, conn, organizationID, domainName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkMail Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading WorkMail Domain (%s): %s", d.Id(), err)
+	}
+
+	d.Set("default", domain.IsDefault)
+	d.Set("dkim_verification_status", domain.DkimVerificationStatus)
+	d.Set("domain_name", domainName)
+	d.Set("is_test_domain", domain.IsTestDomain)
+	d.Set("organization_id", organizationID)
+	d.Set("ownership_verification_status", domain.OwnershipVerificationStatus)
+	if err := d.Set("records", flattenDNSRecords(domain.Records)); err != nil {
+		return diag.Errorf("setting records: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+
+	organizationID, domainName, err := DomainParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// A domain stops being the default only when another domain is made the default.
+	if d.HasChange("default") && d.Get("default").(bool) {
+		if err := updateDefaultMailDomain(ctx, conn, organizationID, domainName); err != nil {
+			return diag.Errorf("setting WorkMail Domain (%s) as default: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDomainRead(ctx, d, meta)
+}
+
+func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+
+	organizationID, domainName, err := DomainParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deregistering WorkMail Domain: %s", d.Id())
+	_, err = conn.DeregisterMailDomainWithContext(ctx, &workmail.DeregisterMailDomainInput{
+		DomainName:     aws.String(domainName),
+		OrganizationId: aws.String(organizationID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeMailDomainNotFoundException, workmail.ErrCodeOrganizationNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deregistering WorkMail Domain (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateDefaultMailDomain(ctx context.Context, conn *workmail.WorkMail, organizationID, domainName string) error {
+	_, err := conn.UpdateDefaultMailDomainWithContext(ctx, &workmail.UpdateDefaultMailDomainInput{
+		DomainName:     aws.String(domainName),
+		OrganizationId: aws.String(organizationID),
+	})
+
+	return err
+}
+
+func flattenDNSRecords(apiObjects []*workmail.DnsRecord) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"hostname": aws.StringValue(apiObject.Hostname),
+			"type":     aws.StringValue(apiObject.Type),
+			"value":    aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
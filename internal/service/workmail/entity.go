@@ -0,0 +1,50 @@
+package workmail
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+// updateEntityEmail registers, re-addresses or deregisters a user, group or resource so that its primary email address is newEmail.
+// An empty address means the entity is not registered to WorkMail (disabled).
+func updateEntityEmail(ctx context.Context, conn *workmail.WorkMail, organizationID, entityID, oldEmail, newEmail string) error {
+	switch {
+	case oldEmail == newEmail:
+		return nil
+	case newEmail == "":
+		return deregisterEntity(ctx, conn, organizationID, entityID)
+	case oldEmail == "":
+		_, err := conn.RegisterToWorkMailWithContext(ctx, &workmail.RegisterToWorkMailInput{
+			Email:          aws.String(newEmail),
+			EntityId:       aws.String(entityID),
+			OrganizationId: aws.String(organizationID),
+		})
+
+		return err
+	default:
+		_, err := conn.UpdatePrimaryEmailAddressWithContext(ctx, &workmail.UpdatePrimaryEmailAddressInput{
+			Email:          aws.String(newEmail),
+			EntityId:       aws.String(entityID),
+			OrganizationId: aws.String(organizationID),
+		})
+
+		return err
+	}
+}
+
+// deregisterEntity disables a user, group or resource, which must be done before it can be deleted.
+func deregisterEntity(ctx context.Context, conn *workmail.WorkMail, organizationID, entityID string) error {
+	_, err := conn.DeregisterFromWorkMailWithContext(ctx, &workmail.DeregisterFromWorkMailInput{
+		EntityId:       aws.String(entityID),
+		OrganizationId: aws.String(organizationID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityStateException) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,257 @@
+package workmail
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupCreate,
+		ReadWithoutTimeout:   resourceGroupRead,
+		UpdateWithoutTimeout: resourceGroupUpdate,
+		DeleteWithoutTimeout: resourceGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"disabled_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 254),
+			},
+			"enabled_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"member_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(12, 256),
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+
+	organizationID := d.Get("organization_id").(string)
+	name := d.Get("name").(string)
+	input := &workmail.CreateGroupInput{
+		Name:           aws.String(name),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	output, err := conn.CreateGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating WorkMail Group (%s): %s", name, err)
+	}
+
+	groupID := aws.StringValue(output.GroupId)
+	d.SetId(EntityCreateResourceID(organizationID, groupID))
+
+	if v, ok := d.GetOk("email"); ok {
+		if err := updateEntityEmail(ctx, conn, organizationID, groupID, "", v.(string)); err != nil {
+			return diag.Errorf("registering WorkMail Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("member_ids"); ok && v.(*schema.Set).Len() > 0 {
+		if err := updateGroupMembers(ctx, conn, organizationID, groupID, nil, v.(*schema.Set).List()); err != nil {
+			return diag.Errorf("adding WorkMail Group (%s) members: %s", d.Id(), err)
+		}
+	}
+
+	return resourceGroupRead(ctx, d, meta)
+}
+
+func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+
+	organizationID, groupID, err := EntityParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	group, err := FindGroupByTwoPartKey(ctx, conn, organizationID, groupID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkMail Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading WorkMail Group (%s): %s", d.Id(), err)
+	}
+
+	if v := group.DisabledDate; v != nil {
+		d.Set("disabled_date", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("disabled_date", nil)
+	}
+	d.Set("email", group.Email)
+	if v := group.EnabledDate; v != nil {
+		d.Set("enabled_date", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("enabled_date", nil)
+	}
+	d.Set("group_id", group.GroupId)
+	d.Set("name", group.Name)
+	d.Set("organization_id", organizationID)
+	d.Set("state", group.State)
+
+	members, err := FindGroupMembers(ctx, conn, organizationID, groupID)
+
+	if err != nil {
+		return diag.Errorf("reading WorkMail Group (%s) members: %s", d.Id(), err)
+	}
+
+	var memberIDs []string
+	for _, v := range members {
+		memberIDs = append(memberIDs, aws.StringValue(v.Id))
+	}
+	d.Set("member_ids", memberIDs)
+
+	return nil
+}
+
+func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+
+	organizationID, groupID, err := EntityParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("email") {
+		o, n := d.GetChange("email")
+
+		if err := updateEntityEmail(ctx, conn, organizationID, groupID, o.(string), n.(string)); err != nil {
+			return diag.Errorf("updating WorkMail Group (%s) email: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("member_ids") {
+		o, n := d.GetChange("member_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if err := updateGroupMembers(ctx, conn, organizationID, groupID, os.Difference(ns).List(), ns.Difference(os).List()); err != nil {
+			return diag.Errorf("updating WorkMail Group (%s) members: %s", d.Id(), err)
+		}
+	}
+
+	return resourceGroupRead(ctx, d, meta)
+}
+
+func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+
+	organizationID, groupID, err := EntityParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("state").(string) == workmail.EntityStateEnabled {
+		err := deregisterEntity(ctx, conn, organizationID, groupID)
+
+		if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return diag.Errorf("deregistering WorkMail Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting WorkMail Group: %s", d.Id())
+	_, err = conn.DeleteGroupWithContext(ctx, &workmail.DeleteGroupInput{
+		GroupId:        aws.String(groupID),
+		OrganizationId: aws.String(organizationID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting WorkMail Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateGroupMembers(ctx context.Context, conn *workmail.WorkMail, organizationID, groupID string, del, add []interface{}) error {
+	for _, v := range del {
+		_, err := conn.DisassociateMemberFromGroupWithContext(ctx, &workmail.DisassociateMemberFromGroupInput{
+			GroupId:        aws.String(groupID),
+			MemberId:       aws.String(v.(string)),
+			OrganizationId: aws.String(organizationID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, v := range add {
+		_, err := conn.AssociateMemberToGroupWithContext(ctx, &workmail.AssociateMemberToGroupInput{
+			GroupId:        aws.String(groupID),
+			MemberId:       aws.String(v.(string)),
+			OrganizationId: aws.String(organizationID),
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
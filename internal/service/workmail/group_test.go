@@ -0,0 +1,159 @@
+package workmail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workmail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkmail "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkMailGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeGroupOutput
+	resourceName := "aws_workmail_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workmail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "email", fmt.Sprintf("team@%s.awsapps.com", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "group_id"),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", "team"),
+					resource.TestCheckResourceAttr(resourceName, "state", workmail.EntityStateEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGroupConfig_members(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_ids.*", "aws_workmail_user.test", "user_id"),
+				),
+			},
+			{
+				Config: testAccGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkMailGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeGroupOutput
+	resourceName := "aws_workmail_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workmail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfworkmail.ResourceGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workmail_group" {
+				continue
+			}
+
+			_, err := tfworkmail.FindGroupByTwoPartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["group_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkMail Group %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGroupExists(ctx context.Context, n string, v *workmail.DescribeGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkMail Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn()
+
+		output, err := tfworkmail.FindGroupByTwoPartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["group_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccGroupConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), `
+resource "aws_workmail_group" "test" {
+  organization_id = aws_workmail_organization.test.id
+  name            = "team"
+  email           = "team@${aws_workmail_organization.test.default_mail_domain}"
+}
+`)
+}
+
+func testAccGroupConfig_members(rName string) string {
+	return acctest.ConfigCompose(testAccUserConfig_basic(rName), `
+resource "aws_workmail_group" "test" {
+  organization_id = aws_workmail_organization.test.id
+  name            = "team"
+  email           = "team@${aws_workmail_organization.test.default_mail_domain}"
+  member_ids      = [aws_workmail_user.test.user_id]
+}
+`)
+}
@@ -0,0 +1,161 @@
+package workmail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workmail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkmail "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkMailMailboxPermission_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.Permission
+	resourceName := "aws_workmail_mailbox_permission.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workmail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMailboxPermissionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMailboxPermissionConfig_basic(rName, `"FULL_ACCESS"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMailboxPermissionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "entity_id", "aws_workmail_user.test", "user_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "grantee_id", "aws_workmail_group.test", "group_id"),
+					resource.TestCheckResourceAttr(resourceName, "grantee_type", workmail.MemberTypeGroup),
+					resource.TestCheckResourceAttr(resourceName, "permission_values.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permission_values.*", workmail.PermissionTypeFullAccess),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMailboxPermissionConfig_basic(rName, `"SEND_AS", "SEND_ON_BEHALF"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMailboxPermissionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "permission_values.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permission_values.*", workmail.PermissionTypeSendAs),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permission_values.*", workmail.PermissionTypeSendOnBehalf),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkMailMailboxPermission_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.Permission
+	resourceName := "aws_workmail_mailbox_permission.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workmail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMailboxPermissionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMailboxPermissionConfig_basic(rName, `"FULL_ACCESS"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMailboxPermissionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfworkmail.ResourceMailboxPermission(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMailboxPermissionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workmail_mailbox_permission" {
+				continue
+			}
+
+			organizationID, entityID, granteeID, err := tfworkmail.MailboxPermissionParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfworkmail.FindMailboxPermissionByThreePartKey(ctx, conn, organizationID, entityID, granteeID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkMail Mailbox Permission %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMailboxPermissionExists(ctx context.Context, n string, v *workmail.Permission) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkMail Mailbox Permission ID is set")
+		}
+
+		organizationID, entityID, granteeID, err := tfworkmail.MailboxPermissionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn()
+
+		output, err := tfworkmail.FindMailboxPermissionByThreePartKey(ctx, conn, organizationID, entityID, granteeID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccMailboxPermissionConfig_basic(rName, permissionValues string) string {
+	return acctest.ConfigCompose(testAccUserConfig_email(rName, "testuser"), fmt.Sprintf(`
+resource "aws_workmail_group" "test" {
+  organization_id = aws_workmail_organization.test.id
+  name            = "delegates"
+  email           = "delegates@${aws_workmail_organization.test.default_mail_domain}"
+}
+
+resource "aws_workmail_mailbox_permission" "test" {
+  organization_id   = aws_workmail_organization.test.id
+  entity_id         = aws_workmail_user.test.user_id
+  grantee_id        = aws_workmail_group.test.group_id
+  permission_values = [%[1]s]
+}
+`, permissionValues))
+}
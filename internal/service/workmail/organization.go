@@ -0,0 +1,266 @@
+package workmail
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceOrganization() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOrganizationCreate,
+		ReadWithoutTimeout:   resourceOrganizationRead,
+		UpdateWithoutTimeout: resourceOrganizationUpdate,
+		DeleteWithoutTimeout: resourceOrganizationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 62),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_mail_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_directory": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"directory_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 209),
+						},
+						"hosted_zone_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"enable_interoperability": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceOrganizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+
+	alias := d.Get("alias").(string)
+	input := &workmail.CreateOrganizationInput{
+		Alias: aws.String(alias),
+	}
+
+	if v, ok := d.GetOk("directory_id"); ok {
+		input.DirectoryId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("domain"); ok && len(v.([]interface{})) > 0 {
+		input.Domains = expandDomains(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("enable_interoperability"); ok {
+		input.EnableInteroperability = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating WorkMail Organization: %s", input)
+	output, err := conn.CreateOrganizationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating WorkMail Organization (%s): %s", alias, err)
+	}
+
+	d.SetId(aws.StringValue(output.OrganizationId))
+
+	organization, err := waitOrganizationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return diag.Errorf("waiting for WorkMail Organization (%s) create: %s", d.Id(), err)
+	}
+
+	// Tags can only be set once the organization is active.
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		if err := UpdateTags(ctx, conn, aws.StringValue(organization.ARN), nil, tags); err != nil {
+			return diag.Errorf("adding WorkMail Organization (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceOrganizationRead(ctx, d, meta)
+}
+
+func resourceOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	organization, err := FindOrganizationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkMail Organization (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading WorkMail Organization (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(organization.ARN)
+	d.Set("alias", organization.Alias)
+	d.Set("arn", arn)
+	d.Set("default_mail_domain", organization.DefaultMailDomain)
+	d.Set("directory_id", organization.DirectoryId)
+	d.Set("directory_type", organization.DirectoryType)
+	d.Set("state", organization.State)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for WorkMail Organization (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceOrganizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating WorkMail Organization (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceOrganizationRead(ctx, d, meta)
+}
+
+func resourceOrganizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+
+	log.Printf("[DEBUG] Deleting WorkMail Organization: %s", d.Id())
+	_, err := conn.DeleteOrganizationWithContext(ctx, &workmail.DeleteOrganizationInput{
+		DeleteDirectory: aws.Bool(d.Get("delete_directory").(bool)),
+		OrganizationId:  aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeOrganizationNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting WorkMail Organization (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitOrganizationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for WorkMail Organization (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDomains(tfList []interface{}) []*workmail.Domain {
+	var apiObjects []*workmail.Domain
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &workmail.Domain{
+			DomainName: aws.String(tfMap["domain_name"].(string)),
+		}
+
+		if v, ok := tfMap["hosted_zone_id"].(string); ok && v != "" {
+			apiObject.HostedZoneId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,288 @@
+package workmail
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceResource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourceCreate,
+		ReadWithoutTimeout:   resourceResourceRead,
+		UpdateWithoutTimeout: resourceResourceUpdate,
+		DeleteWithoutTimeout: resourceResourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"booking_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_accept_requests": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"auto_decline_conflicting_requests": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"auto_decline_recurring_requests": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"disabled_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 254),
+			},
+			"enabled_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 20),
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(workmail.ResourceType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+
+	organizationID := d.Get("organization_id").(string)
+	name := d.Get("name").(string)
+	input := &workmail.CreateResourceInput{
+		Name:           aws.String(name),
+		OrganizationId: aws.String(organizationID),
+		Type:           aws.String(d.Get("type").(string)),
+	}
+
+	output, err := conn.CreateResourceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating WorkMail Resource (%s): %s", name, err)
+	}
+
+	resourceID := aws.StringValue(output.ResourceId)
+	d.SetId(EntityCreateResourceID(organizationID, resourceID))
+
+	if v, ok := d.GetOk("booking_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		_, err := conn.UpdateResourceWithContext(ctx, &workmail.UpdateResourceInput{
+			BookingOptions: expandBookingOptions(v.([]interface{})[0].(map[string]interface{})),
+			OrganizationId: aws.String(organizationID),
+			ResourceId:     aws.String(resourceID),
+		})
+
+		if err != nil {
+			return diag.Errorf("setting WorkMail Resource (%s) booking options: %s", d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("email"); ok {
+		if err := updateEntityEmail(ctx, conn, organizationID, resourceID, "", v.(string)); err != nil {
+			return diag.Errorf("registering WorkMail Resource (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceResourceRead(ctx, d, meta)
+}
+
+func resourceResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+
+	organizationID, resourceID, err := EntityParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resource, err := FindResourceByTwoPartKey(ctx, conn, organizationID, resourceID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkMail Resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading WorkMail Resource (%s): %s", d.Id(), err)
+	}
+
+	if resource.BookingOptions != nil {
+		if err := d.Set("booking_options", []interface{}{flattenBookingOptions(resource.BookingOptions)}); err != nil {
+			return diag.Errorf("setting booking_options: %s", err)
+		}
+	} else {
+		d.Set("booking_options", nil)
+	}
+	if v := resource.DisabledDate; v != nil {
+		d.Set("disabled_date", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("disabled_date", nil)
+	}
+	d.Set("email", resource.Email)
+	if v := resource.EnabledDate; v != nil {
+		d.Set("enabled_date", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("enabled_date", nil)
+	}
+	d.Set("name", resource.Name)
+	d.Set("organization_id", organizationID)
+	d.Set("resource_id", resource.ResourceId)
+	d.Set("state", resource.State)
+	d.Set("type", resource.Type)
+
+	return nil
+}
+
+func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+
+	organizationID, resourceID, err := EntityParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("booking_options", "name") {
+		input := &workmail.UpdateResourceInput{
+			OrganizationId: aws.String(organizationID),
+			ResourceId:     aws.String(resourceID),
+		}
+
+		if d.HasChange("booking_options") {
+			if v, ok := d.GetOk("booking_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.BookingOptions = expandBookingOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		_, err := conn.UpdateResourceWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating WorkMail Resource (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("email") {
+		o, n := d.GetChange("email")
+
+		if err := updateEntityEmail(ctx, conn, organizationID, resourceID, o.(string), n.(string)); err != nil {
+			return diag.Errorf("updating WorkMail Resource (%s) email: %s", d.Id(), err)
+		}
+	}
+
+	return resourceResourceRead(ctx, d, meta)
+}
+
+func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+
+	organizationID, resourceID, err := EntityParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("state").(string) == workmail.EntityStateEnabled {
+		err := deregisterEntity(ctx, conn, organizationID, resourceID)
+
+		if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return diag.Errorf("deregistering WorkMail Resource (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting WorkMail Resource: %s", d.Id())
+	_, err = conn.DeleteResourceWithContext(ctx, &workmail.DeleteResourceInput{
+		OrganizationId: aws.String(organizationID),
+		ResourceId:     aws.String(resourceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting WorkMail Resource (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBookingOptions(tfMap map[string]interface{}) *workmail.BookingOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &workmail.BookingOptions{
+		AutoAcceptRequests:             aws.Bool(tfMap["auto_accept_requests"].(bool)),
+		AutoDeclineConflictingRequests: aws.Bool(tfMap["auto_decline_conflicting_requests"].(bool)),
+		AutoDeclineRecurringRequests:   aws.Bool(tfMap["auto_decline_recurring_requests"].(bool)),
+	}
+}
+
+func flattenBookingOptions(apiObject *workmail.BookingOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"auto_accept_requests":              aws.BoolValue(apiObject.AutoAcceptRequests),
+		"auto_decline_conflicting_requests": aws.BoolValue(apiObject.AutoDeclineConflictingRequests),
+		"auto_decline_recurring_requests":   aws.BoolValue(apiObject.AutoDeclineRecurringRequests),
+	}
+}
@@ -0,0 +1,198 @@
+package workmail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workmail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkmail "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkMailResource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeResourceOutput
+	resourceName := "aws_workmail_resource.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workmail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_basic(rName, "room1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "email", fmt.Sprintf("room1@%s.awsapps.com", rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", "room1"),
+					resource.TestCheckResourceAttrSet(resourceName, "resource_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", workmail.EntityStateEnabled),
+					resource.TestCheckResourceAttr(resourceName, "type", workmail.ResourceTypeRoom),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceConfig_basic(rName, "room2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "email", fmt.Sprintf("room2@%s.awsapps.com", rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", "room2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkMailResource_bookingOptions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeResourceOutput
+	resourceName := "aws_workmail_resource.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workmail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_bookingOptions(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "booking_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "booking_options.0.auto_accept_requests", "false"),
+					resource.TestCheckResourceAttr(resourceName, "booking_options.0.auto_decline_conflicting_requests", "true"),
+					resource.TestCheckResourceAttr(resourceName, "booking_options.0.auto_decline_recurring_requests", "true"),
+					resource.TestCheckResourceAttr(resourceName, "type", workmail.ResourceTypeEquipment),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceConfig_bookingOptions(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "booking_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "booking_options.0.auto_accept_requests", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkMailResource_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeResourceOutput
+	resourceName := "aws_workmail_resource.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workmail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_basic(rName, "room1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfworkmail.ResourceResource(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckResourceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workmail_resource" {
+				continue
+			}
+
+			_, err := tfworkmail.FindResourceByTwoPartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["resource_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkMail Resource %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceExists(ctx context.Context, n string, v *workmail.DescribeResourceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkMail Resource ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn()
+
+		output, err := tfworkmail.FindResourceByTwoPartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["resource_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccResourceConfig_basic(rName, name string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_resource" "test" {
+  organization_id = aws_workmail_organization.test.id
+  name            = %[1]q
+  type            = "ROOM"
+  email           = "%[1]s@${aws_workmail_organization.test.default_mail_domain}"
+}
+`, name))
+}
+
+func testAccResourceConfig_bookingOptions(rName string, autoAccept bool) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_resource" "test" {
+  organization_id = aws_workmail_organization.test.id
+  name            = "projector"
+  type            = "EQUIPMENT"
+
+  booking_options {
+    auto_accept_requests              = %[1]t
+    auto_decline_conflicting_requests = true
+    auto_decline_recurring_requests   = true
+  }
+}
+`, autoAccept))
+}
@@ -0,0 +1,219 @@
+package workmail
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceUser() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserCreate,
+		ReadWithoutTimeout:   resourceUserRead,
+		UpdateWithoutTimeout: resourceUserUpdate,
+		DeleteWithoutTimeout: resourceUserDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"disabled_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 254),
+			},
+			"enabled_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+
+	organizationID := d.Get("organization_id").(string)
+	name := d.Get("name").(string)
+	input := &workmail.CreateUserInput{
+		DisplayName:    aws.String(d.Get("display_name").(string)),
+		Name:           aws.String(name),
+		OrganizationId: aws.String(organizationID),
+		Password:       aws.String(d.Get("password").(string)),
+	}
+
+	output, err := conn.CreateUserWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating WorkMail User (%s): %s", name, err)
+	}
+
+	d.SetId(EntityCreateResourceID(organizationID, aws.StringValue(output.UserId)))
+
+	if v, ok := d.GetOk("email"); ok {
+		if err := updateEntityEmail(ctx, conn, organizationID, aws.StringValue(output.UserId), "", v.(string)); err != nil {
+			return diag.Errorf("registering WorkMail User (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceUserRead(ctx, d, meta)
+}
+
+func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+
+	organizationID, userID, err := EntityParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	user, err := FindUserByTwoPartKey(ctx, conn, organizationID, userID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkMail User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading WorkMail User (%s): %s", d.Id(), err)
+	}
+
+	if v := user.DisabledDate; v != nil {
+		d.Set("disabled_date", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("disabled_date", nil)
+	}
+	d.Set("display_name", user.DisplayName)
+	d.Set("email", user.Email)
+	if v := user.EnabledDate; v != nil {
+		d.Set("enabled_date", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("enabled_date", nil)
+	}
+	d.Set("name", user.Name)
+	d.Set("organization_id", organizationID)
+	d.Set("state", user.State)
+	d.Set("user_id", user.UserId)
+	d.Set("user_role", user.UserRole)
+
+	return nil
+}
+
+func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+
+	organizationID, userID, err := EntityParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("email") {
+		o, n := d.GetChange("email")
+
+		if err := updateEntityEmail(ctx, conn, organizationID, userID, o.(string), n.(string)); err != nil {
+			return diag.Errorf("updating WorkMail User (%s) email: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("password") {
+		_, err := conn.ResetPasswordWithContext(ctx, &workmail.ResetPasswordInput{
+			OrganizationId: aws.String(organizationID),
+			Password:       aws.String(d.Get("password").(string)),
+			UserId:         aws.String(userID),
+		})
+
+		if err != nil {
+			return diag.Errorf("resetting WorkMail User (%s) password: %s", d.Id(), err)
+		}
+	}
+
+	return resourceUserRead(ctx, d, meta)
+}
+
+func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn()
+
+	organizationID, userID, err := EntityParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("state").(string) == workmail.EntityStateEnabled {
+		err := deregisterEntity(ctx, conn, organizationID, userID)
+
+		if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return diag.Errorf("deregistering WorkMail User (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting WorkMail User: %s", d.Id())
+	_, err = conn.DeleteUserWithContext(ctx, &workmail.DeleteUserInput{
+		OrganizationId: aws.String(organizationID),
+		UserId:         aws.String(userID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting WorkMail User (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package workmail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workmail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkmail "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkMailUser_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeUserOutput
+	resourceName := "aws_workmail_user.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workmail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Test User"),
+					resource.TestCheckResourceAttr(resourceName, "email", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", "testuser"),
+					resource.TestCheckResourceAttrPair(resourceName, "organization_id", "aws_workmail_organization.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", workmail.EntityStateDisabled),
+					resource.TestCheckResourceAttrSet(resourceName, "user_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				Config: testAccUserConfig_email(rName, "testuser"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "email", fmt.Sprintf("testuser@%s.awsapps.com", rName)),
+					acctest.CheckResourceAttrRFC3339(resourceName, "enabled_date"),
+					resource.TestCheckResourceAttr(resourceName, "state", workmail.EntityStateEnabled),
+				),
+			},
+			{
+				Config: testAccUserConfig_email(rName, "renamed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "email", fmt.Sprintf("renamed@%s.awsapps.com", rName)),
+					resource.TestCheckResourceAttr(resourceName, "state", workmail.EntityStateEnabled),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkMailUser_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workmail.DescribeUserOutput
+	resourceName := "aws_workmail_user.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, workmail.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfworkmail.ResourceUser(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckUserDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workmail_user" {
+				continue
+			}
+
+			_, err := tfworkmail.FindUserByTwoPartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["user_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkMail User %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckUserExists(ctx context.Context, n string, v *workmail.DescribeUserOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkMail User ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn()
+
+		output, err := tfworkmail.FindUserByTwoPartKey(ctx, conn, rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["user_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccUserConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), `
+resource "aws_workmail_user" "test" {
+  organization_id = aws_workmail_organization.test.id
+  name            = "testuser"
+  display_name    = "Test User"
+  password        = "Avoid-plaintext-passw0rds"
+}
+`)
+}
+
+func testAccUserConfig_email(rName, localPart string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_user" "test" {
+  organization_id = aws_workmail_organization.test.id
+  name            = "testuser"
+  display_name    = "Test User"
+  password        = "Avoid-plaintext-passw0rds"
+  email           = "%[1]s@${aws_workmail_organization.test.default_mail_domain}"
+}
+`, localPart))
+}
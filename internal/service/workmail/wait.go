@@ -0,0 +1,50 @@
+package workmail
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitOrganizationCreated(ctx context.Context, conn *workmail.WorkMail, id string, timeout time.Duration) (*workmail.DescribeOrganizationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{organizationStateRequested, organizationStateCreating},
+		Target:  []string{organizationStateActive},
+		Refresh: statusOrganizationState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*workmail.DescribeOrganizationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitOrganizationDeleted(ctx context.Context, conn *workmail.WorkMail, id string, timeout time.Duration) (*workmail.DescribeOrganizationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{organizationStateActive, organizationStateDeleting},
+		Target:  []string{},
+		Refresh: statusOrganizationState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*workmail.DescribeOrganizationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
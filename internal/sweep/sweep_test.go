@@ -89,6 +89,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/location"
@@ -103,12 +104,14 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/polly"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ram"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/resourceexplorer2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycontrolconfig"
@@ -125,6 +128,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ses"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/simpledb"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/snowball"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
@@ -132,12 +136,15 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/swf"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/timestreamquery"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/translate"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
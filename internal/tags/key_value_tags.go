@@ -33,6 +33,12 @@ type IgnoreConfig struct {
 	KeyPrefixes KeyValueTags
 }
 
+// PreventDestroyConfig contains resource tags which prevent resource deletion.
+type PreventDestroyConfig struct {
+	Keys KeyValueTags
+	Tags KeyValueTags
+}
+
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
 // The AWS Go SDK is split into multiple service packages, each service with
 // its own Go struct type representing a resource tag. To standardize logic
@@ -91,6 +97,32 @@ func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
 	return dc.Tags.ContainsAll(tags)
 }
 
+// ProtectingKeys returns the sorted keys of any of the given tags that
+// prevent resource deletion under the configuration.
+// A tag matches if its key is in Keys, or if its key and value are in Tags.
+func (pc *PreventDestroyConfig) ProtectingKeys(tags KeyValueTags) []string {
+	if pc == nil {
+		return nil
+	}
+
+	var result []string
+
+	for k, v := range tags {
+		if pc.Keys.KeyExists(k) {
+			result = append(result, k)
+			continue
+		}
+
+		if want := pc.Tags.KeyValue(k); want != nil && v != nil && v.Value != nil && *v.Value == *want {
+			result = append(result, k)
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
 // IgnoreConfig returns any tags not removed by a given configuration.
 func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	if config == nil {
@@ -1,6 +1,7 @@
 package tags
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -572,6 +573,101 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsPreventDestroyConfigProtectingKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		tags   KeyValueTags
+		config *PreventDestroyConfig
+		want   []string
+	}{
+		{
+			name: "no config",
+			tags: New(map[string]string{
+				"protected": "true",
+			}),
+			config: nil,
+			want:   nil,
+		},
+		{
+			name: "empty config",
+			tags: New(map[string]string{
+				"protected": "true",
+			}),
+			config: &PreventDestroyConfig{},
+			want:   nil,
+		},
+		{
+			name: "no tags",
+			tags: New(map[string]string{}),
+			config: &PreventDestroyConfig{
+				Keys: New([]string{"protected"}),
+			},
+			want: nil,
+		},
+		{
+			name: "keys match",
+			tags: New(map[string]string{
+				"key1":      "value1",
+				"protected": "",
+			}),
+			config: &PreventDestroyConfig{
+				Keys: New([]string{"protected"}),
+			},
+			want: []string{"protected"},
+		},
+		{
+			name: "tags match",
+			tags: New(map[string]string{
+				"key1":      "value1",
+				"protected": "true",
+			}),
+			config: &PreventDestroyConfig{
+				Tags: New(map[string]string{"protected": "true"}),
+			},
+			want: []string{"protected"},
+		},
+		{
+			name: "tags value mismatch",
+			tags: New(map[string]string{
+				"key1":      "value1",
+				"protected": "false",
+			}),
+			config: &PreventDestroyConfig{
+				Tags: New(map[string]string{"protected": "true"}),
+			},
+			want: nil,
+		},
+		{
+			name: "keys and tags match",
+			tags: New(map[string]string{
+				"key1":      "value1",
+				"protected": "true",
+				"retain":    "yes",
+			}),
+			config: &PreventDestroyConfig{
+				Keys: New([]string{"retain"}),
+				Tags: New(map[string]string{"protected": "true"}),
+			},
+			want: []string{"protected", "retain"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.config.ProtectingKeys(testCase.tags)
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreElasticbeanstalk(t *testing.T) {
 	t.Parallel()
 
@@ -168,7 +168,7 @@ const (
 	LakeFormation                = "lakeformation"
 	Lambda                       = "lambda"
 	LexModels                    = "lexmodels"
-	LexModelsV2                  = "lexmodelsv2"
+	LexModelsV2                  = "lexv2models"
 	LexRuntime                   = "lexruntime"
 	LexRuntimeV2                 = "lexruntimev2"
 	LicenseManager               = "licensemanager"